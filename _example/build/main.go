@@ -14,7 +14,7 @@ func main() {
 		Tag:     []string{"name1:tag1", "name2:tag2"},
 	}, []string{"."})
 
-	log.Println("command:", cmd)
+	log.Println("command:", docker.Redact(cmd))
 
 	out, err := cmd.CombinedOutput()
 	fmt.Println(string(out))
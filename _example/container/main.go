@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/w-haibara/docker-wrapper/docker"
+)
+
+func main() {
+	ctx := context.Background()
+
+	publishAll := true
+	c, err := docker.StartContainer(ctx, docker.DockerRunOption{
+		PublishAll: &publishAll,
+	}, []string{"nginx:alpine"}, docker.WaitForHTTP{Port: "80/tcp"})
+	if c != nil {
+		defer c.Remove(ctx)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(c.ID, c.Name, c.Ports)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -46,7 +47,11 @@ func generateStructDefinition(cmd *cobra.Command, names []string) string {
 	fields := ""
 	fieldValues := []fieldValue{}
 	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
-		fields += "	/*\n	" + flag.Usage + "\n	*/\n"
+		fields += "	/*\n	" + flag.Usage + "\n"
+		if msg := flagDeprecation(flag); msg != "" {
+			fields += "\n	Deprecated: " + msg + "\n"
+		}
+		fields += "	*/\n"
 		name := generateFieldName(flag)
 		typ := generateTypeName(flag)
 		fields += "	" + name +
@@ -187,3 +192,144 @@ func toCamel(s []string) string {
 	}
 	return res
 }
+
+func GenerateRegistryCode(cmd *cobra.Command) {
+	fmt.Print(`package docker
+
+import "os/exec"
+
+var commands = []CommandInfo{
+`)
+
+	generateRegistryCode(cmd, nil)
+	fmt.Print("}\n")
+	os.Exit(0)
+}
+
+func generateRegistryCode(cmd *cobra.Command, parents []string) {
+	var names []string
+	if parents == nil {
+		names = []string{cmd.Name()}
+	} else {
+		names = append(parents, cmd.Name())
+	}
+
+	fmt.Print(generateCommandInfo(cmd, names))
+	for _, cmd := range cmd.Commands() {
+		generateRegistryCode(cmd, names)
+	}
+}
+
+func generateCommandInfo(cmd *cobra.Command, names []string) string {
+	cmdName := generateCmdName(names)
+
+	flags := ""
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		flags += "		{"
+		flags += "Name: " + strconv.Quote(flag.Name) + ", "
+		if flag.Shorthand != "" {
+			flags += "Shorthand: " + strconv.Quote(flag.Shorthand) + ", "
+		}
+		flags += "Field: " + strconv.Quote(generateFieldName(flag)) + ", "
+		flags += "Type: " + strconv.Quote(flag.Value.Type()) + ", "
+		flags += "Default: " + strconv.Quote(flag.DefValue) + ", "
+		flags += "Usage: " + strconv.Quote(flag.Usage)
+		if msg := flagDeprecation(flag); msg != "" {
+			flags += ", Deprecated: " + strconv.Quote(msg)
+		}
+		if flag.Hidden {
+			flags += ", Hidden: true"
+		}
+		flags += generateAnnotations(func(key string) (string, bool) {
+			values, ok := flag.Annotations[key]
+			return strings.Join(values, ","), ok
+		}, ", ")
+		flags += "},\n"
+	})
+
+	result := "	{\n"
+	result += "		Name: " + strconv.Quote(cmdName) + ",\n"
+	result += "		Path: []string{\"" + strings.Join(names, "\", \"") + "\"},\n"
+	if len(cmd.Aliases) > 0 {
+		result += "		Aliases: []string{\"" + strings.Join(cmd.Aliases, "\", \"") + "\"},\n"
+	}
+	result += "		Use: " + strconv.Quote(cmd.Use) + ",\n"
+	result += "		Short: " + strconv.Quote(cmd.Short) + ",\n"
+	if cmd.Deprecated != "" {
+		result += "		Deprecated: " + strconv.Quote(cmd.Deprecated) + ",\n"
+	}
+	if cmd.Hidden {
+		result += "		Hidden: true,\n"
+	}
+	if a := generateAnnotations(func(key string) (string, bool) {
+		value, ok := cmd.Annotations[key]
+		return value, ok
+	}, ""); a != "" {
+		result += "		" + a + ",\n"
+	}
+	if flags != "" {
+		result += "		Flags: []FlagInfo{\n" + flags + "		},\n"
+		result += "		option: func() interface{} { return &" + cmdName + "Option{} },\n"
+		result += "		cmd: func(opt interface{}, args []string) *exec.Cmd {\n"
+		result += "			return " + cmdName + "Cmd(*opt.(*" + cmdName + "Option), args)\n"
+		result += "		},\n"
+	} else {
+		result += "		cmd: func(_ interface{}, args []string) *exec.Cmd {\n"
+		result += "			return " + cmdName + "Cmd(args)\n"
+		result += "		},\n"
+	}
+	result += "	},\n"
+
+	return result
+}
+
+// deprecatedFlags lists flags deprecated in docs/deprecated.md of docker/cli
+// that carry no deprecation annotation.
+var deprecatedFlags = map[string]string{
+	"default-stack-orchestrator": "Kubernetes stack and context support is deprecated",
+	"kernel-memory":              "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels",
+	"stream":                     "the experimental --stream flag was removed in docker 20.10",
+}
+
+func flagDeprecation(flag *pflag.Flag) string {
+	if flag.Deprecated != "" {
+		return flag.Deprecated
+	}
+	if msg, ok := deprecatedFlags[flag.Name]; ok {
+		return msg
+	}
+	if _, ok := flag.Annotations["deprecated"]; ok {
+		if _, ok := flag.Annotations["kubernetes"]; ok {
+			return "Kubernetes stack and context support is deprecated"
+		}
+		return "this flag is deprecated"
+	}
+	return ""
+}
+
+func generateAnnotations(lookup func(key string) (string, bool), prefix string) string {
+	fields := ""
+	if v, ok := lookup("version"); ok {
+		fields += ", MinAPIVersion: " + strconv.Quote(v)
+	}
+	if v, ok := lookup("ostype"); ok {
+		fields += ", OSType: " + strconv.Quote(v)
+	}
+	for _, a := range []struct{ key, field string }{
+		{"experimental", "Experimental"},
+		{"experimentalCLI", "ExperimentalCLI"},
+		{"swarm", "Swarm"},
+		{"kubernetes", "Kubernetes"},
+		{"buildkit", "BuildKit"},
+		{"no-buildkit", "NoBuildKit"},
+	} {
+		if _, ok := lookup(a.key); ok {
+			fields += ", " + a.field + ": true"
+		}
+	}
+
+	if fields == "" {
+		return ""
+	}
+	return prefix + "Annotations: Annotations{" + fields[2:] + "}"
+}
@@ -0,0 +1,611 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+/*
+ImageArchive reads a tarball written by 'docker save'. Both the classic
+docker-archive layout (manifest.json, repositories, <id>/layer.tar) and the
+OCI image layout (oci-layout, index.json, blobs/) are understood.
+*/
+type ImageArchive struct {
+	Images []ArchiveImage
+
+	r       io.ReadSeeker
+	closer  io.Closer
+	entries map[string]archiveEntry
+
+	// merged caches the merged filesystems of the images read by ReadFile.
+	merged map[string]map[string]mergedFile
+}
+
+type archiveEntry struct {
+	offset int64
+	size   int64
+}
+
+// ArchiveImage is an image stored in an ImageArchive.
+type ArchiveImage struct {
+	// ID is the digest of the image configuration.
+	ID         string
+	RepoTags   []string
+	ConfigPath string
+	Config     ImageConfig
+	Layers     []ArchiveLayer
+}
+
+// ArchiveLayer is a layer of an ArchiveImage, ordered from the base up.
+type ArchiveLayer struct {
+	Path string
+	// DiffID is the digest of the uncompressed layer from the image config.
+	DiffID string
+	// Digest is the digest of the stored blob, if the archive records it.
+	Digest    string
+	MediaType string
+	Size      int64
+}
+
+// ImageConfig is the image configuration blob.
+type ImageConfig struct {
+	Architecture string    `json:"architecture"`
+	OS           string    `json:"os"`
+	Variant      string    `json:"variant,omitempty"`
+	Created      time.Time `json:"created"`
+	Author       string    `json:"author,omitempty"`
+	Config       struct {
+		User         string              `json:"User,omitempty"`
+		ExposedPorts map[string]struct{} `json:"ExposedPorts,omitempty"`
+		Env          []string            `json:"Env,omitempty"`
+		Entrypoint   []string            `json:"Entrypoint,omitempty"`
+		Cmd          []string            `json:"Cmd,omitempty"`
+		Volumes      map[string]struct{} `json:"Volumes,omitempty"`
+		WorkingDir   string              `json:"WorkingDir,omitempty"`
+		Labels       map[string]string   `json:"Labels,omitempty"`
+		StopSignal   string              `json:"StopSignal,omitempty"`
+	} `json:"config"`
+	RootFS struct {
+		Type    string   `json:"type"`
+		DiffIDs []string `json:"diff_ids"`
+	} `json:"rootfs"`
+	History []struct {
+		Created    time.Time `json:"created"`
+		CreatedBy  string    `json:"created_by,omitempty"`
+		Comment    string    `json:"comment,omitempty"`
+		EmptyLayer bool      `json:"empty_layer,omitempty"`
+	} `json:"history,omitempty"`
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	Config ociDescriptor   `json:"config"`
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type dockerManifest struct {
+	Config       string
+	RepoTags     []string
+	Layers       []string
+	LayerSources map[string]ociDescriptor `json:",omitempty"`
+}
+
+// OpenImageArchive opens the archive file at path. The archive must be
+// closed when no longer used.
+func OpenImageArchive(path string) (*ImageArchive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := ReadImageArchive(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	a.closer = f
+	return a, nil
+}
+
+// Close closes the file opened by OpenImageArchive.
+func (a *ImageArchive) Close() error {
+	if a.closer == nil {
+		return nil
+	}
+	return a.closer.Close()
+}
+
+// ReadImageArchive indexes the archive read from r, which must stay open
+// while the archive is used.
+func ReadImageArchive(r io.ReadSeeker) (*ImageArchive, error) {
+	a := &ImageArchive{r: r, entries: map[string]archiveEntry{}}
+
+	// Classic archives store layers shared by several images once, linking
+	// the other copies to it, e.g. <id>/layer.tar -> ../<other>/layer.tar.
+	links := map[string]string{}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read image archive: %w", err)
+		}
+
+		name := cleanArchivePath(hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeReg:
+			offset, err := r.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+			a.entries[name] = archiveEntry{offset: offset, size: hdr.Size}
+		case tar.TypeSymlink:
+			links[name] = cleanArchivePath(path.Join(path.Dir(name), hdr.Linkname))
+		case tar.TypeLink:
+			links[name] = cleanArchivePath(hdr.Linkname)
+		}
+	}
+	a.resolveLinks(links)
+
+	var err error
+	switch {
+	case a.has("manifest.json"):
+		err = a.readDockerManifest()
+	case a.has("index.json"):
+		err = a.readOCIIndex()
+	default:
+		err = fmt.Errorf("neither manifest.json nor index.json found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read image archive: %w", err)
+	}
+
+	return a, nil
+}
+
+// resolveLinks adds the links whose targets are files, following links to
+// links. Dangling links and cycles are left out.
+func (a *ImageArchive) resolveLinks(links map[string]string) {
+	for name, target := range links {
+		for hops := 0; hops <= len(links); hops++ {
+			if e, ok := a.entries[target]; ok {
+				a.entries[name] = e
+				break
+			}
+			next, ok := links[target]
+			if !ok {
+				break
+			}
+			target = next
+		}
+	}
+}
+
+func (a *ImageArchive) has(name string) bool {
+	_, ok := a.entries[name]
+	return ok
+}
+
+// Open returns a reader for the file name of the archive itself, e.g.
+// "manifest.json" or a layer path.
+func (a *ImageArchive) Open(name string) (io.Reader, error) {
+	e, ok := a.entries[cleanArchivePath(name)]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if _, err := a.r.Seek(e.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return io.LimitReader(a.r, e.size), nil
+}
+
+func (a *ImageArchive) readJSON(name string, v interface{}) error {
+	r, err := a.Open(name)
+	if err != nil {
+		return err
+	}
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("decode %s: %w", name, err)
+	}
+	return nil
+}
+
+func (a *ImageArchive) readDockerManifest() error {
+	var manifests []dockerManifest
+	if err := a.readJSON("manifest.json", &manifests); err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		img := ArchiveImage{
+			ID:         "sha256:" + strings.TrimSuffix(path.Base(m.Config), ".json"),
+			RepoTags:   m.RepoTags,
+			ConfigPath: m.Config,
+		}
+		if err := a.readJSON(m.Config, &img.Config); err != nil {
+			return err
+		}
+
+		for i, p := range m.Layers {
+			layer := ArchiveLayer{Path: p, Size: a.entries[cleanArchivePath(p)].size}
+			if i < len(img.Config.RootFS.DiffIDs) {
+				layer.DiffID = img.Config.RootFS.DiffIDs[i]
+			}
+			if src, ok := m.LayerSources[layer.DiffID]; ok {
+				layer.Digest = src.Digest
+				layer.MediaType = src.MediaType
+			} else if d, ok := blobDigest(p); ok {
+				layer.Digest = d
+			}
+			img.Layers = append(img.Layers, layer)
+		}
+
+		a.Images = append(a.Images, img)
+	}
+
+	if a.has("repositories") {
+		var repos map[string]map[string]string
+		if err := a.readJSON("repositories", &repos); err != nil {
+			return err
+		}
+		a.addRepositories(repos)
+	}
+
+	return nil
+}
+
+// addRepositories adds the tags of the legacy repositories file that
+// manifest.json does not already list.
+func (a *ImageArchive) addRepositories(repos map[string]map[string]string) {
+	for repo, tags := range repos {
+		for tag, id := range tags {
+			ref := repo + ":" + tag
+			for i := range a.Images {
+				img := &a.Images[i]
+				if len(img.Layers) == 0 || path.Dir(img.Layers[len(img.Layers)-1].Path) != id {
+					continue
+				}
+				if !containsString(img.RepoTags, ref) {
+					img.RepoTags = append(img.RepoTags, ref)
+				}
+			}
+		}
+	}
+}
+
+func (a *ImageArchive) readOCIIndex() error {
+	var index ociIndex
+	if err := a.readJSON("index.json", &index); err != nil {
+		return err
+	}
+	return a.readOCIDescriptors(index.Manifests, "")
+}
+
+func (a *ImageArchive) readOCIDescriptors(descs []ociDescriptor, name string) error {
+	for _, desc := range descs {
+		ref := name
+		if n := desc.Annotations["io.containerd.image.name"]; n != "" {
+			ref = n
+		} else if n := desc.Annotations["org.opencontainers.image.ref.name"]; n != "" && ref == "" {
+			ref = n
+		}
+
+		switch desc.MediaType {
+		case "application/vnd.oci.image.index.v1+json", "application/vnd.docker.distribution.manifest.list.v2+json":
+			var index ociIndex
+			if err := a.readJSON(blobPath(desc.Digest), &index); err != nil {
+				return err
+			}
+			if err := a.readOCIDescriptors(index.Manifests, ref); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var m ociManifest
+		if err := a.readJSON(blobPath(desc.Digest), &m); err != nil {
+			return err
+		}
+		if !a.has(blobPath(m.Config.Digest)) {
+			// Manifests of other platforms whose blobs were not saved.
+			continue
+		}
+
+		img := ArchiveImage{ID: m.Config.Digest, ConfigPath: blobPath(m.Config.Digest)}
+		if ref != "" {
+			img.RepoTags = []string{ref}
+		}
+		if err := a.readJSON(img.ConfigPath, &img.Config); err != nil {
+			return err
+		}
+
+		for i, l := range m.Layers {
+			layer := ArchiveLayer{
+				Path:      blobPath(l.Digest),
+				Digest:    l.Digest,
+				MediaType: l.MediaType,
+				Size:      l.Size,
+			}
+			if i < len(img.Config.RootFS.DiffIDs) {
+				layer.DiffID = img.Config.RootFS.DiffIDs[i]
+			}
+			img.Layers = append(img.Layers, layer)
+		}
+
+		a.mergeImage(img)
+	}
+	return nil
+}
+
+// mergeImage adds img, or only its tags when the same image is already
+// listed under another name.
+func (a *ImageArchive) mergeImage(img ArchiveImage) {
+	for i := range a.Images {
+		if a.Images[i].ID != img.ID {
+			continue
+		}
+		for _, t := range img.RepoTags {
+			if !containsString(a.Images[i].RepoTags, t) {
+				a.Images[i].RepoTags = append(a.Images[i].RepoTags, t)
+			}
+		}
+		return
+	}
+	a.Images = append(a.Images, img)
+}
+
+// Image returns the image with the given ID or repository tag.
+func (a *ImageArchive) Image(ref string) (*ArchiveImage, error) {
+	for i := range a.Images {
+		img := &a.Images[i]
+		if img.ID == ref || strings.TrimPrefix(img.ID, "sha256:") == ref || containsString(img.RepoTags, ref) {
+			return img, nil
+		}
+	}
+	return nil, fmt.Errorf("image %s not found in archive", ref)
+}
+
+// OpenLayer returns the uncompressed tar stream of layer.
+func (a *ImageArchive) OpenLayer(layer ArchiveLayer) (io.Reader, error) {
+	r, err := a.Open(layer.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return gzip.NewReader(br)
+	}
+	return br, nil
+}
+
+/*
+ReadFile returns the contents of name in the merged filesystem of img, as
+seen by a container: whiteout files of upper layers hide the files below
+them, and symbolic links are followed across layers, in the parent
+directories of name as well as name itself, e.g. /etc/os-release linking to
+../usr/lib/os-release of a lower layer. Hard links are read from their
+target in the same layer.
+*/
+func (a *ImageArchive) ReadFile(img *ArchiveImage, name string) ([]byte, error) {
+	files, err := a.mergedFiles(img)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := resolveMergedPath(files, cleanArchivePath(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	f, ok := files[resolved]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return a.readLayerFile(img.Layers[f.layer], resolved, 0)
+}
+
+// mergedFile is an entry of the merged filesystem of an image.
+type mergedFile struct {
+	// layer is the index of the layer holding the entry.
+	layer    int
+	typeflag byte
+	linkname string
+}
+
+// mergedFiles returns the entries of the merged filesystem of img, reading
+// its layers from the bottom up the first time.
+func (a *ImageArchive) mergedFiles(img *ArchiveImage) (map[string]mergedFile, error) {
+	if files, ok := a.merged[img.ID]; ok {
+		return files, nil
+	}
+
+	files := map[string]mergedFile{}
+	for i, layer := range img.Layers {
+		r, err := a.OpenLayer(layer)
+		if err != nil {
+			return nil, err
+		}
+
+		// Whiteouts only hide the entries of lower layers, so the entries
+		// of the layer are added once all of them are applied.
+		var hidden, opaque []string
+		added := map[string]mergedFile{}
+		tr := tar.NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("read layer %s: %w", layer.Path, err)
+			}
+
+			entry := cleanArchivePath(hdr.Name)
+			dir, base := path.Split(entry)
+			switch {
+			case base == ".wh..wh..opq":
+				opaque = append(opaque, strings.TrimSuffix(dir, "/"))
+			case strings.HasPrefix(base, ".wh."):
+				hidden = append(hidden, dir+strings.TrimPrefix(base, ".wh."))
+			default:
+				f := mergedFile{layer: i, typeflag: hdr.Typeflag, linkname: hdr.Linkname}
+				if hdr.Typeflag == tar.TypeLink {
+					f.linkname = cleanArchivePath(hdr.Linkname)
+				}
+				added[entry] = f
+			}
+		}
+
+		for name := range files {
+			for _, dir := range opaque {
+				if isParentPath(dir, name) {
+					delete(files, name)
+				}
+			}
+			for _, h := range hidden {
+				if name == h || strings.HasPrefix(name, h+"/") {
+					delete(files, name)
+				}
+			}
+		}
+		for name, f := range added {
+			files[name] = f
+		}
+	}
+
+	if a.merged == nil {
+		a.merged = map[string]map[string]mergedFile{}
+	}
+	a.merged[img.ID] = files
+	return files, nil
+}
+
+// resolveMergedPath follows the symbolic links in name, which is relative
+// to the root, and returns the path it refers to. Links never lead above
+// the root, as in a container.
+func resolveMergedPath(files map[string]mergedFile, name string) (string, error) {
+	resolved := ""
+	rest := strings.Split(name, "/")
+	for hops := 0; len(rest) > 0; {
+		part := rest[0]
+		rest = rest[1:]
+
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			if resolved = path.Dir(resolved); resolved == "." {
+				resolved = ""
+			}
+			continue
+		}
+
+		next := path.Join(resolved, part)
+		f, ok := files[next]
+		if !ok || f.typeflag != tar.TypeSymlink {
+			resolved = next
+			continue
+		}
+		if hops++; hops > maxLinkHops {
+			return "", errors.New("too many levels of symbolic links")
+		}
+		if path.IsAbs(f.linkname) {
+			resolved = ""
+		}
+		rest = append(strings.Split(f.linkname, "/"), rest...)
+	}
+	return resolved, nil
+}
+
+// readLayerFile reads the regular file name of layer. hops counts the hard
+// links followed to reach name.
+func (a *ImageArchive) readLayerFile(layer ArchiveLayer, name string, hops int) ([]byte, error) {
+	r, err := a.OpenLayer(layer)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read layer %s: %w", layer.Path, err)
+		}
+		if cleanArchivePath(hdr.Name) != name {
+			continue
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeLink:
+			// Hard links point to a file of the same layer.
+			if hops >= maxLinkHops {
+				return nil, fmt.Errorf("%s: too many links", name)
+			}
+			return a.readLayerFile(layer, cleanArchivePath(hdr.Linkname), hops+1)
+		case tar.TypeReg:
+			return io.ReadAll(tr)
+		default:
+			return nil, fmt.Errorf("%s is not a regular file", name)
+		}
+	}
+}
+
+const maxLinkHops = 40
+
+func isParentPath(dir, name string) bool {
+	return dir == "" || strings.HasPrefix(name, dir+"/")
+}
+
+func cleanArchivePath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+func blobPath(digest string) string {
+	algo, hex, _ := strings.Cut(digest, ":")
+	return "blobs/" + algo + "/" + hex
+}
+
+func blobDigest(p string) (string, bool) {
+	dir, hex := path.Split(cleanArchivePath(p))
+	if !strings.HasPrefix(dir, "blobs/") {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(dir, "blobs/"), "/") + ":" + hex, true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	linkname string
+	data     string
+}
+
+func buildTar(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Typeflag: e.typeflag, Linkname: e.linkname, Mode: 0644}
+		if e.typeflag == tar.TypeReg {
+			hdr.Size = int64(len(e.data))
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(tw, e.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadImageArchiveLinkedLayers(t *testing.T) {
+	layer := string(buildTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "etc/os-release", typeflag: tar.TypeReg, data: "ID=test\n"},
+		{name: "etc/os-release.link", typeflag: tar.TypeLink, linkname: "etc/os-release"},
+	}))
+	config := `{"architecture":"amd64","os":"linux","rootfs":{"type":"layers","diff_ids":["sha256:aa","sha256:aa"]}}`
+	manifest := `[
+		{"Config":"cfg.json","RepoTags":["a:latest"],"Layers":["aaa/layer.tar"]},
+		{"Config":"cfg.json","RepoTags":["b:latest"],"Layers":["bbb/layer.tar"]},
+		{"Config":"cfg.json","RepoTags":["c:latest"],"Layers":["ccc/layer.tar"]}
+	]`
+	data := buildTar(t, []tarEntry{
+		{name: "aaa/", typeflag: tar.TypeDir},
+		{name: "aaa/layer.tar", typeflag: tar.TypeReg, data: layer},
+		{name: "bbb/", typeflag: tar.TypeDir},
+		{name: "bbb/layer.tar", typeflag: tar.TypeSymlink, linkname: "../aaa/layer.tar"},
+		{name: "ccc/", typeflag: tar.TypeDir},
+		{name: "ccc/layer.tar", typeflag: tar.TypeLink, linkname: "bbb/layer.tar"},
+		{name: "cfg.json", typeflag: tar.TypeReg, data: config},
+		{name: "manifest.json", typeflag: tar.TypeReg, data: manifest},
+	})
+
+	a, err := ReadImageArchive(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ref  string
+		file string
+		want string
+	}{
+		{"a:latest", "/etc/os-release", "ID=test\n"},
+		{"b:latest", "/etc/os-release", "ID=test\n"},
+		{"c:latest", "/etc/os-release", "ID=test\n"},
+		{"b:latest", "/etc/os-release.link", "ID=test\n"},
+	}
+	for _, tt := range tests {
+		img, err := a.Image(tt.ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := img.Layers[0].Size; got != int64(len(layer)) {
+			t.Errorf("%s: layer size = %d, want %d", tt.ref, got, len(layer))
+		}
+		got, err := a.ReadFile(img, tt.file)
+		if err != nil {
+			t.Errorf("ReadFile(%s, %s): %v", tt.ref, tt.file, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("ReadFile(%s, %s) = %q, want %q", tt.ref, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestReadImageArchiveDanglingLinks(t *testing.T) {
+	data := buildTar(t, []tarEntry{
+		{name: "manifest.json", typeflag: tar.TypeReg, data: "[]"},
+		{name: "x", typeflag: tar.TypeSymlink, linkname: "y"},
+		{name: "y", typeflag: tar.TypeSymlink, linkname: "x"},
+		{name: "z", typeflag: tar.TypeSymlink, linkname: "missing"},
+	})
+
+	a, err := ReadImageArchive(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"x", "y", "z"} {
+		if _, err := a.Open(name); err == nil {
+			t.Errorf("Open(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestReadFileMergedLinks(t *testing.T) {
+	base := string(buildTar(t, []tarEntry{
+		{name: "usr/lib/os-release", typeflag: tar.TypeReg, data: "ID=base\n"},
+		{name: "usr/share/doc/readme", typeflag: tar.TypeReg, data: "docs\n"},
+		{name: "opt/app/old", typeflag: tar.TypeReg, data: "old\n"},
+		{name: "var/cache/x", typeflag: tar.TypeReg, data: "x\n"},
+	}))
+	top := string(buildTar(t, []tarEntry{
+		{name: "etc/os-release", typeflag: tar.TypeSymlink, linkname: "../usr/lib/os-release"},
+		{name: "doc", typeflag: tar.TypeSymlink, linkname: "/usr/share/doc"},
+		{name: "share", typeflag: tar.TypeSymlink, linkname: "usr/share"},
+		{name: "opt/.wh.app", typeflag: tar.TypeReg},
+		{name: "var/cache/.wh..wh..opq", typeflag: tar.TypeReg},
+		{name: "var/cache/y", typeflag: tar.TypeReg, data: "y\n"},
+		{name: "loop", typeflag: tar.TypeSymlink, linkname: "loop"},
+	}))
+	config := `{"architecture":"amd64","os":"linux","rootfs":{"type":"layers","diff_ids":["sha256:aa","sha256:bb"]}}`
+	manifest := `[{"Config":"cfg.json","RepoTags":["a:latest"],"Layers":["aa/layer.tar","bb/layer.tar"]}]`
+	data := buildTar(t, []tarEntry{
+		{name: "aa/layer.tar", typeflag: tar.TypeReg, data: base},
+		{name: "bb/layer.tar", typeflag: tar.TypeReg, data: top},
+		{name: "cfg.json", typeflag: tar.TypeReg, data: config},
+		{name: "manifest.json", typeflag: tar.TypeReg, data: manifest},
+	})
+
+	a, err := ReadImageArchive(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := a.Image("a:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		file    string
+		want    string
+		wantErr bool
+	}{
+		{file: "/etc/os-release", want: "ID=base\n"},
+		{file: "/doc/readme", want: "docs\n"},
+		{file: "/share/doc/readme", want: "docs\n"},
+		{file: "/etc/../share/doc/../../usr/lib/os-release", want: "ID=base\n"},
+		{file: "/var/cache/y", want: "y\n"},
+		{file: "/var/cache/x", wantErr: true},
+		{file: "/opt/app/old", wantErr: true},
+		{file: "/loop", wantErr: true},
+		{file: "/usr/share", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := a.ReadFile(img, tt.file)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReadFile(%s) error = %v, wantErr %v", tt.file, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && string(got) != tt.want {
+			t.Errorf("ReadFile(%s) = %q, want %q", tt.file, got, tt.want)
+		}
+	}
+}
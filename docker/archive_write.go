@@ -0,0 +1,348 @@
+package docker
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"runtime"
+	"strings"
+	"time"
+)
+
+/*
+ImagePatch describes changes applied on top of a base image by
+WriteImageArchive. Files are put into one new layer, placed above the
+uncompressed layer tarballs of Layers. Env entries replace variables of the
+same name; Labels are merged into the existing labels. Nil slices and empty
+strings leave the base configuration unchanged.
+*/
+type ImagePatch struct {
+	Tags []string
+
+	Layers [][]byte
+	Files  []PatchFile
+
+	Labels     map[string]string
+	Env        []string
+	Entrypoint []string
+	Cmd        []string
+	WorkingDir string
+	User       string
+}
+
+// PatchFile is a file added to the image by an ImagePatch.
+type PatchFile struct {
+	Name string
+	Mode fs.FileMode
+	Uid  int
+	Gid  int
+	Data []byte
+}
+
+/*
+WriteImageArchive writes a docker-archive tarball to w containing img of
+base with patch applied. A nil img starts from an empty image for the
+running platform, and base is then ignored; otherwise img must be an image
+of base. Layers compressed in an OCI base archive are written uncompressed,
+as the docker-archive layout expects.
+*/
+func WriteImageArchive(w io.Writer, base *ImageArchive, img *ArchiveImage, patch ImagePatch) error {
+	config := map[string]interface{}{
+		"architecture": runtime.GOARCH,
+		"os":           "linux",
+		"config":       map[string]interface{}{},
+		"rootfs":       map[string]interface{}{"type": "layers", "diff_ids": []interface{}{}},
+	}
+	if img != nil && base == nil {
+		return errors.New("write image archive: image without a base archive")
+	}
+	if img != nil {
+		r, err := base.Open(img.ConfigPath)
+		if err != nil {
+			return err
+		}
+		config = map[string]interface{}{}
+		if err := json.NewDecoder(r).Decode(&config); err != nil {
+			return fmt.Errorf("decode image config: %w", err)
+		}
+	}
+
+	layers := patch.Layers
+	if len(patch.Files) > 0 {
+		layer, err := filesLayer(patch.Files)
+		if err != nil {
+			return err
+		}
+		layers = append(append([][]byte{}, layers...), layer)
+	}
+
+	var diffIDs []string
+	for _, layer := range layers {
+		diffIDs = append(diffIDs, digestOf(layer))
+	}
+	patchConfig(config, patch, diffIDs)
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	configName := strings.TrimPrefix(digestOf(configJSON), "sha256:") + ".json"
+
+	tw := tar.NewWriter(w)
+	manifest := dockerManifest{Config: configName, RepoTags: patch.Tags}
+	written := map[string]bool{}
+
+	if img != nil {
+		for _, layer := range img.Layers {
+			name := layerPath(layer.DiffID)
+			manifest.Layers = append(manifest.Layers, name)
+			if written[name] {
+				continue
+			}
+			written[name] = true
+
+			// Layers are named by DiffID, the digest of the uncompressed
+			// tarball, which docker load checks them against.
+			size, err := base.uncompressedSize(layer)
+			if err != nil {
+				return err
+			}
+			r, err := base.OpenLayer(layer)
+			if err != nil {
+				return err
+			}
+			if err := writeTarFile(tw, name, size, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, layer := range layers {
+		name := layerPath(diffIDs[i])
+		manifest.Layers = append(manifest.Layers, name)
+		if written[name] {
+			continue
+		}
+		written[name] = true
+
+		if err := writeTarFile(tw, name, int64(len(layer)), bytes.NewReader(layer)); err != nil {
+			return err
+		}
+	}
+
+	if err := writeTarFile(tw, configName, int64(len(configJSON)), bytes.NewReader(configJSON)); err != nil {
+		return err
+	}
+
+	manifestJSON, err := json.Marshal([]dockerManifest{manifest})
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, "manifest.json", int64(len(manifestJSON)), bytes.NewReader(manifestJSON)); err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// uncompressedSize returns the size of the tarball of layer. Compressed
+// layers, as stored in OCI archives, are read through once to find it; only
+// gzip is supported.
+func (a *ImageArchive) uncompressedSize(layer ArchiveLayer) (int64, error) {
+	r, err := a.Open(layer.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return 0, fmt.Errorf("layer %s: %w", layer.Path, err)
+		}
+		n, err := io.Copy(io.Discard, zr)
+		if err != nil {
+			return 0, fmt.Errorf("layer %s: %w", layer.Path, err)
+		}
+		return n, nil
+	case bytes.Equal(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return 0, fmt.Errorf("layer %s: zstd compressed layers are not supported", layer.Path)
+	}
+	return a.entries[cleanArchivePath(layer.Path)].size, nil
+}
+
+func patchConfig(config map[string]interface{}, patch ImagePatch, diffIDs []string) {
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	config["created"] = now
+
+	c, _ := config["config"].(map[string]interface{})
+	if c == nil {
+		c = map[string]interface{}{}
+		config["config"] = c
+	}
+
+	if len(patch.Labels) > 0 {
+		labels, _ := c["Labels"].(map[string]interface{})
+		if labels == nil {
+			labels = map[string]interface{}{}
+		}
+		for k, v := range patch.Labels {
+			labels[k] = v
+		}
+		c["Labels"] = labels
+	}
+
+	if len(patch.Env) > 0 {
+		var env []interface{}
+		if old, ok := c["Env"].([]interface{}); ok {
+			for _, e := range old {
+				s, _ := e.(string)
+				if !envOverridden(s, patch.Env) {
+					env = append(env, s)
+				}
+			}
+		}
+		for _, e := range patch.Env {
+			env = append(env, e)
+		}
+		c["Env"] = env
+	}
+
+	if patch.Entrypoint != nil {
+		c["Entrypoint"] = patch.Entrypoint
+	}
+	if patch.Cmd != nil {
+		c["Cmd"] = patch.Cmd
+	}
+	if patch.WorkingDir != "" {
+		c["WorkingDir"] = patch.WorkingDir
+	}
+	if patch.User != "" {
+		c["User"] = patch.User
+	}
+
+	rootfs, _ := config["rootfs"].(map[string]interface{})
+	if rootfs == nil {
+		rootfs = map[string]interface{}{"type": "layers"}
+		config["rootfs"] = rootfs
+	}
+	ids, _ := rootfs["diff_ids"].([]interface{})
+	history, _ := config["history"].([]interface{})
+	for _, id := range diffIDs {
+		ids = append(ids, id)
+		history = append(history, map[string]interface{}{
+			"created":    now,
+			"created_by": "docker-wrapper",
+		})
+	}
+	if len(diffIDs) == 0 {
+		history = append(history, map[string]interface{}{
+			"created":     now,
+			"created_by":  "docker-wrapper",
+			"empty_layer": true,
+		})
+	}
+	rootfs["diff_ids"] = ids
+	config["history"] = history
+}
+
+func envOverridden(entry string, env []string) bool {
+	name, _, _ := strings.Cut(entry, "=")
+	for _, e := range env {
+		if n, _, _ := strings.Cut(e, "="); n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func filesLayer(files []PatchFile) ([]byte, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		mode := f.Mode
+		if mode == 0 {
+			mode = 0644
+		}
+		hdr := &tar.Header{
+			Name:     cleanArchivePath(f.Name),
+			Mode:     int64(mode.Perm()),
+			Uid:      f.Uid,
+			Gid:      f.Gid,
+			Size:     int64(len(f.Data)),
+			Typeflag: tar.TypeReg,
+			ModTime:  time.Now(),
+			Format:   tar.FormatPAX,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		Typeflag: tar.TypeReg,
+		ModTime:  time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
+
+func layerPath(diffID string) string {
+	return strings.TrimPrefix(diffID, "sha256:") + "/layer.tar"
+}
+
+func digestOf(b []byte) string {
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// LoadImageArchive streams the archive produced by write into
+// 'docker load' and returns its output.
+func LoadImageArchive(ctx context.Context, opt DockerLoadOption, write func(io.Writer) error) (string, error) {
+	opt.Input = nil
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(write(pw))
+	}()
+
+	cmd := DockerLoadCmd(opt, nil)
+	cmd.Stdin = pr
+	out, err := output(ctx, cmd)
+	pr.Close()
+	return string(out), err
+}
+
+// PatchImage applies patch to img of base and loads the result into the
+// docker daemon.
+func PatchImage(ctx context.Context, base *ImageArchive, img *ArchiveImage, patch ImagePatch) (string, error) {
+	return LoadImageArchive(ctx, DockerLoadOption{}, func(w io.Writer) error {
+		return WriteImageArchive(w, base, img, patch)
+	})
+}
@@ -0,0 +1,220 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestFilesLayer(t *testing.T) {
+	layer, err := filesLayer([]PatchFile{
+		{Name: "/etc/app.conf", Data: []byte("debug=1\n")},
+		{Name: "usr/local/bin/app", Mode: 0755, Uid: 1000, Gid: 1000, Data: []byte("#!/bin/sh\n")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type entry struct {
+		name     string
+		mode     int64
+		uid, gid int
+		data     string
+	}
+	var got []entry
+	tr := tar.NewReader(bytes.NewReader(layer))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(tr)
+		got = append(got, entry{hdr.Name, hdr.Mode, hdr.Uid, hdr.Gid, string(data)})
+	}
+
+	want := []entry{
+		{"etc/app.conf", 0644, 0, 0, "debug=1\n"},
+		{"usr/local/bin/app", 0755, 1000, 1000, "#!/bin/sh\n"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("entries = %+v, want %+v", got, want)
+	}
+}
+
+func TestEnvOverridden(t *testing.T) {
+	env := []string{"PATH=/bin", "DEBUG"}
+	tests := []struct {
+		entry string
+		want  bool
+	}{
+		{"PATH=/usr/bin:/bin", true},
+		{"DEBUG=1", true},
+		{"PATHS=x", false},
+		{"HOME=/root", false},
+	}
+	for _, tt := range tests {
+		if got := envOverridden(tt.entry, env); got != tt.want {
+			t.Errorf("envOverridden(%q) = %v, want %v", tt.entry, got, tt.want)
+		}
+	}
+}
+
+func TestPatchConfig(t *testing.T) {
+	var config map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"config": {"Env": ["PATH=/bin", "HOME=/root"], "Labels": {"a": "1"}, "Cmd": ["sh"]},
+		"rootfs": {"type": "layers", "diff_ids": ["sha256:base"]},
+		"history": [{"created_by": "base"}]
+	}`), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patchConfig(config, ImagePatch{
+		Env:        []string{"PATH=/usr/bin:/bin"},
+		Labels:     map[string]string{"b": "2"},
+		Entrypoint: []string{"/app"},
+		User:       "app",
+	}, []string{"sha256:patch"})
+
+	c := config["config"].(map[string]interface{})
+	checks := []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"Env", c["Env"], []interface{}{"HOME=/root", "PATH=/usr/bin:/bin"}},
+		{"Labels", c["Labels"], map[string]interface{}{"a": "1", "b": "2"}},
+		{"Entrypoint", c["Entrypoint"], []string{"/app"}},
+		{"Cmd", c["Cmd"], []interface{}{"sh"}},
+		{"User", c["User"], "app"},
+		{"diff_ids", config["rootfs"].(map[string]interface{})["diff_ids"], []interface{}{"sha256:base", "sha256:patch"}},
+		{"history", len(config["history"].([]interface{})), 2},
+	}
+	for _, tt := range checks {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	empty := map[string]interface{}{}
+	patchConfig(empty, ImagePatch{}, nil)
+	history := empty["history"].([]interface{})
+	if len(history) != 1 || history[0].(map[string]interface{})["empty_layer"] != true {
+		t.Errorf("history = %v, want one empty layer entry", history)
+	}
+}
+
+// ociArchive builds an OCI layout archive of one image whose layers are
+// stored gzip compressed.
+func ociArchive(t *testing.T, layers ...[]byte) []byte {
+	t.Helper()
+	var entries []tarEntry
+	blob := func(data []byte) string {
+		d := digestOf(data)
+		entries = append(entries, tarEntry{name: blobPath(d), typeflag: tar.TypeReg, data: string(data)})
+		return d
+	}
+
+	var diffIDs []string
+	var descs []ociDescriptor
+	for _, layer := range layers {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		zw.Write(layer)
+		zw.Close()
+		diffIDs = append(diffIDs, digestOf(layer))
+		descs = append(descs, ociDescriptor{
+			MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+			Digest:    blob(buf.Bytes()),
+			Size:      int64(buf.Len()),
+		})
+	}
+
+	config, _ := json.Marshal(map[string]interface{}{
+		"architecture": "amd64",
+		"os":           "linux",
+		"config":       map[string]interface{}{"Env": []string{"PATH=/bin"}},
+		"rootfs":       map[string]interface{}{"type": "layers", "diff_ids": diffIDs},
+	})
+	manifest, _ := json.Marshal(ociManifest{
+		Config: ociDescriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: blob(config)},
+		Layers: descs,
+	})
+	index, _ := json.Marshal(ociIndex{Manifests: []ociDescriptor{{
+		MediaType:   "application/vnd.oci.image.manifest.v1+json",
+		Digest:      blob(manifest),
+		Annotations: map[string]string{"io.containerd.image.name": "docker.io/library/base:latest"},
+	}}})
+	entries = append(entries,
+		tarEntry{name: "oci-layout", typeflag: tar.TypeReg, data: `{"imageLayoutVersion":"1.0.0"}`},
+		tarEntry{name: "index.json", typeflag: tar.TypeReg, data: string(index)},
+	)
+	return buildTar(t, entries)
+}
+
+func TestWriteImageArchiveRoundTrip(t *testing.T) {
+	baseLayer := buildTar(t, []tarEntry{{name: "etc/os-release", typeflag: tar.TypeReg, data: "ID=base\n"}})
+	base, err := ReadImageArchive(bytes.NewReader(ociArchive(t, baseLayer)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := base.Image("docker.io/library/base:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = WriteImageArchive(&buf, base, img, ImagePatch{
+		Tags:   []string{"app:latest"},
+		Files:  []PatchFile{{Name: "/app/config", Data: []byte("x=1\n")}},
+		Env:    []string{"PATH=/app:/bin"},
+		Labels: map[string]string{"app": "yes"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := ReadImageArchive(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := a.Image("app:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out.Layers) != 2 {
+		t.Fatalf("layers = %d, want 2", len(out.Layers))
+	}
+	// docker load checks each layer tarball against its DiffID.
+	for _, layer := range out.Layers {
+		r, err := a.Open(layer.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(r)
+		if got := digestOf(data); got != layer.DiffID || layer.Path != layerPath(layer.DiffID) {
+			t.Errorf("layer %s has digest %s, want its DiffID %s", layer.Path, got, layer.DiffID)
+		}
+	}
+
+	for file, want := range map[string]string{"/etc/os-release": "ID=base\n", "/app/config": "x=1\n"} {
+		got, err := a.ReadFile(out, file)
+		if err != nil || string(got) != want {
+			t.Errorf("ReadFile(%s) = %q, %v, want %q", file, got, err, want)
+		}
+	}
+	if want := []string{"PATH=/app:/bin"}; !reflect.DeepEqual(out.Config.Config.Env, want) {
+		t.Errorf("Env = %q, want %q", out.Config.Config.Env, want)
+	}
+	if out.Config.Config.Labels["app"] != "yes" {
+		t.Errorf("Labels = %v, want app=yes", out.Config.Config.Labels)
+	}
+}
@@ -0,0 +1,199 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+/*
+Batch configures the batch operations such as StopContainers, which pass
+the targets to docker in chunks of ChunkSize and run up to Concurrency
+commands at a time. Zero values select the defaults: 10 targets per
+command and 4 concurrent commands.
+*/
+type Batch struct {
+	ChunkSize   int
+	Concurrency int
+}
+
+func (b Batch) withDefaults() Batch {
+	if b.ChunkSize <= 0 {
+		b.ChunkSize = 10
+	}
+	if b.Concurrency <= 0 {
+		b.Concurrency = 4
+	}
+	return b
+}
+
+// BatchResult is the outcome of a batch operation for one target.
+type BatchResult struct {
+	Target string
+
+	// Err is a *CmdError whose Stderr holds the lines about the target, or
+	// the whole error of the command if none could be told apart.
+	Err error
+}
+
+// StopContainers runs `docker stop` on containers in batches and returns
+// one result per container, in the same order.
+func StopContainers(ctx context.Context, opt DockerStopOption, containers []string, batch Batch) []BatchResult {
+	return runBatch(ctx, containers, batch, true, func(chunk []string) *exec.Cmd {
+		return DockerStopCmd(opt, chunk)
+	})
+}
+
+// KillContainers runs `docker kill` on containers in batches.
+func KillContainers(ctx context.Context, opt DockerKillOption, containers []string, batch Batch) []BatchResult {
+	return runBatch(ctx, containers, batch, true, func(chunk []string) *exec.Cmd {
+		return DockerKillCmd(opt, chunk)
+	})
+}
+
+// PauseContainers runs `docker pause` on containers in batches.
+func PauseContainers(ctx context.Context, containers []string, batch Batch) []BatchResult {
+	return runBatch(ctx, containers, batch, true, DockerPauseCmd)
+}
+
+// RemoveContainers runs `docker rm` on containers in batches.
+func RemoveContainers(ctx context.Context, opt DockerRmOption, containers []string, batch Batch) []BatchResult {
+	return runBatch(ctx, containers, batch, true, func(chunk []string) *exec.Cmd {
+		return DockerRmCmd(opt, chunk)
+	})
+}
+
+// RemoveImages runs `docker rmi` on images in batches.
+func RemoveImages(ctx context.Context, opt DockerRmiOption, images []string, batch Batch) []BatchResult {
+	return runBatch(ctx, images, batch, false, func(chunk []string) *exec.Cmd {
+		return DockerRmiCmd(opt, chunk)
+	})
+}
+
+// BatchErrors returns the failed results of a batch operation combined
+// into one error, or nil if all succeeded.
+func BatchErrors(results []BatchResult) error {
+	var errs []error
+	for _, r := range results {
+		errs = append(errs, r.Err)
+	}
+	return joinErrors(errs)
+}
+
+/*
+runBatch runs the commands returned by cmd for chunks of targets. Docker
+goes on with the other targets when one fails, so a failed command is
+split into per-target results: with echoes, the command prints each target
+it handled, as `docker stop` and `docker rm` do, and the others failed;
+otherwise the targets mentioned in the error output failed. Errors not
+mentioning any target fail the whole chunk.
+*/
+func runBatch(ctx context.Context, targets []string, batch Batch, echoes bool, cmd func(chunk []string) *exec.Cmd) []BatchResult {
+	batch = batch.withDefaults()
+	results := make([]BatchResult, len(targets))
+	for i, target := range targets {
+		results[i].Target = target
+	}
+	sem := make(chan struct{}, batch.Concurrency)
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(targets); start += batch.ChunkSize {
+		end := start + batch.ChunkSize
+		if end > len(targets) {
+			end = len(targets)
+		}
+
+		wg.Add(1)
+		go func(chunk []BatchResult, names []string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				for i := range chunk {
+					chunk[i].Err = err
+				}
+				return
+			}
+			out, err := partialOutput(ctx, cmd(names))
+			splitBatchError(chunk, out, err, echoes)
+		}(results[start:end], targets[start:end])
+	}
+	wg.Wait()
+
+	return results
+}
+
+func splitBatchError(chunk []BatchResult, out []byte, err error, echoes bool) {
+	if err == nil {
+		return
+	}
+	var cmdErr *CmdError
+	if !errors.As(err, &cmdErr) {
+		for i := range chunk {
+			chunk[i].Err = err
+		}
+		return
+	}
+
+	done := map[string]bool{}
+	for _, line := range strings.Split(string(out), "\n") {
+		done[strings.TrimSpace(line)] = true
+	}
+	lines := strings.Split(strings.TrimSpace(cmdErr.Stderr), "\n")
+
+	mentioned := false
+	for i := range chunk {
+		var msgs []string
+		for _, line := range lines {
+			if mentionsTarget(line, chunk[i].Target) {
+				msgs = append(msgs, line)
+			}
+		}
+		if len(msgs) > 0 {
+			e := *cmdErr
+			e.Stderr = strings.Join(msgs, "\n")
+			chunk[i].Err = &e
+			mentioned = true
+		} else if echoes && !done[chunk[i].Target] {
+			chunk[i].Err = err
+		}
+	}
+	if !mentioned && !echoes {
+		for i := range chunk {
+			chunk[i].Err = err
+		}
+	}
+}
+
+// mentionsTarget reports whether an error line of docker mentions target,
+// e.g. "Error response from daemon: No such container: web" mentions "web".
+// Hex IDs may be shortened to 12 digits or more on either side, names and
+// references must match exactly.
+func mentionsTarget(line, target string) bool {
+	id := strings.TrimPrefix(target, "sha256:")
+	for _, word := range strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == '"' || r == '\''
+	}) {
+		word = strings.TrimRight(strings.TrimLeft(word, "/("), ":,.)")
+		if word == target || word == id {
+			return true
+		}
+		word = strings.TrimPrefix(word, "sha256:")
+		if len(word) >= 12 && len(id) >= 12 && isHex(word) && isHex(id) && (strings.HasPrefix(id, word) || strings.HasPrefix(word, id)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMentionsTarget(t *testing.T) {
+	const id = "sha256:0a97eee8041e2b6c0e65abb2700b0705d0da5525ca69060b9e0bde8a3d17afdb"
+	tests := []struct {
+		line   string
+		target string
+		want   bool
+	}{
+		{"Error response from daemon: No such container: web", "web", true},
+		{"Error response from daemon: No such container: my-container-1", "my-container-10", false},
+		{"Error response from daemon: No such container: my-container-10", "my-container-1", false},
+		{`Error: No such image: "alpine:3.14"`, "alpine:3.14", true},
+		{"Error response from daemon: conflict: unable to delete 0a97eee8041e (must be forced)", id, true},
+		{"Error response from daemon: conflict: unable to delete 0a97eee8041e (must be forced)", "0a97eee8041e", true},
+		{"Error response from daemon: No such image: " + id, "0a97eee8041e", true},
+		{"Error response from daemon: conflict: unable to delete 0a97eee8041f (must be forced)", id, false},
+		{"Error response from daemon: No such container: abc", "abcdef", false},
+	}
+
+	for _, tt := range tests {
+		if got := mentionsTarget(tt.line, tt.target); got != tt.want {
+			t.Errorf("mentionsTarget(%q, %q) = %v, want %v", tt.line, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestSplitBatchError(t *testing.T) {
+	err := &CmdError{Err: errors.New("exit status 1"), Stderr: "Error response from daemon: No such container: my-container-1"}
+	chunk := []BatchResult{{Target: "my-container-1"}, {Target: "my-container-10"}}
+
+	splitBatchError(chunk, []byte("my-container-10\n"), err, true)
+	if chunk[0].Err == nil {
+		t.Errorf("%s: no error", chunk[0].Target)
+	}
+	if chunk[1].Err != nil {
+		t.Errorf("%s: %v", chunk[1].Target, chunk[1].Err)
+	}
+}
@@ -0,0 +1,142 @@
+package docker
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+Client runs commands against one daemon, selected by the global options in
+Global such as Host or Context, and caches what it learns about the daemon.
+
+Before running a command the client checks its flags against the daemon,
+so that a flag the daemon is too old for fails with
+
+	docker run: flag --platform requires API version 1.32, but the daemon supports 1.30
+
+instead of docker's own "unknown flag" error.
+*/
+type Client struct {
+	Global DockerOption
+
+	mu      sync.Mutex
+	version *Version
+}
+
+// NewClient returns a client for the daemon selected by global.
+func NewClient(global DockerOption) *Client {
+	return &Client{Global: global}
+}
+
+// Version returns the client and server versions. The first result with a
+// server version is cached; while the daemon cannot be reached, the client
+// version alone is returned and the daemon is asked again next time.
+func (c *Client) Version(ctx context.Context) (*Version, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.version != nil {
+		return c.version, nil
+	}
+	v, err := GetVersion(ctx, c.Global)
+	if err != nil {
+		return nil, err
+	}
+	if v.Server != nil {
+		c.version = v
+	}
+	return v, nil
+}
+
+// Features returns the daemon features derived from Version, with BuildKit
+// set if DOCKER_BUILDKIT is set in the environment.
+func (c *Client) Features(ctx context.Context) (*DaemonFeatures, error) {
+	v, err := c.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := v.Features()
+	if d == nil {
+		d = &DaemonFeatures{}
+	}
+	d.BuildKit = buildKitEnv(os.Environ())
+	return d, nil
+}
+
+// Command returns a copy of cmd with the client's global options inserted
+// after "docker".
+func (c *Client) Command(cmd *exec.Cmd) *exec.Cmd {
+	return withGlobal(cmd, c.Global)
+}
+
+/*
+Check returns a *UsageError if cmd uses a command or flag the daemon does
+not support: one that needs a newer API version, another operating system,
+experimental features or BuildKit. Deprecated flags are not rejected.
+Commands that cannot be parsed are left for docker to report.
+*/
+func (c *Client) Check(ctx context.Context, cmd *exec.Cmd) error {
+	p, err := ParseArgs(c.Command(cmd).Args)
+	if err != nil {
+		return nil
+	}
+
+	d, err := c.Features(ctx)
+	if err != nil {
+		return err
+	}
+	if cmd.Env != nil {
+		if b := buildKitEnv(cmd.Env); b != nil {
+			d.BuildKit = b
+		}
+	}
+
+	var unsupported []UsageIssue
+	for _, issue := range p.Check(d) {
+		if !issue.Deprecated {
+			unsupported = append(unsupported, issue)
+		}
+	}
+	if len(unsupported) > 0 {
+		return &UsageError{Issues: unsupported}
+	}
+	return nil
+}
+
+// Output checks cmd with Check, runs it with the client's global options
+// and returns its standard output.
+func (c *Client) Output(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	if err := c.Check(ctx, cmd); err != nil {
+		return nil, err
+	}
+	return output(ctx, c.Command(cmd))
+}
+
+// withGlobal returns a copy of cmd with the flags of global inserted after
+// "docker".
+func withGlobal(cmd *exec.Cmd, global DockerOption) *exec.Cmd {
+	args := append(DockerCmd(global, nil).Args[1:], cmd.Args[1:]...)
+	c := exec.Command(cmd.Args[0], args...)
+	c.Env = cmd.Env
+	c.Dir = cmd.Dir
+	c.Stdin = cmd.Stdin
+	c.Stdout = cmd.Stdout
+	c.Stderr = cmd.Stderr
+	return c
+}
+
+func buildKitEnv(env []string) *bool {
+	var enabled *bool
+	for _, e := range env {
+		if strings.HasPrefix(e, "DOCKER_BUILDKIT=") {
+			b, _ := strconv.ParseBool(strings.TrimPrefix(e, "DOCKER_BUILDKIT="))
+			enabled = &b
+		}
+	}
+	return enabled
+}
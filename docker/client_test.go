@@ -0,0 +1,45 @@
+package docker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClientVersionRetriesUnreachableDaemon(t *testing.T) {
+	up := filepath.Join(t.TempDir(), "up")
+	fakeDocker(t, `if [ -e `+up+` ]; then
+	echo '{"Client":{"Version":"24.0.5","ApiVersion":"1.43"},"Server":{"Version":"24.0.5","ApiVersion":"1.43"}}'
+else
+	echo '{"Client":{"Version":"24.0.5","ApiVersion":"1.43"},"Server":null}'
+	echo 'Cannot connect to the Docker daemon' >&2
+	exit 1
+fi
+`)
+
+	c := NewClient(DockerOption{})
+	v, err := c.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Server != nil {
+		t.Fatalf("Server = %+v, want nil while the daemon is down", v.Server)
+	}
+
+	if err := os.WriteFile(up, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	v, err = c.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Server == nil || v.Server.APIVersion != "1.43" {
+		t.Fatalf("Server = %+v, want the daemon's version once it is up", v.Server)
+	}
+
+	os.Remove(up)
+	if v, err := c.Version(context.Background()); err != nil || v.Server == nil {
+		t.Errorf("Version = %+v, %v, want the cached server version", v, err)
+	}
+}
@@ -0,0 +1,3246 @@
+package docker
+
+import "os/exec"
+
+var commands = []CommandInfo{
+	{
+		Name:  "Docker",
+		Path:  []string{"docker"},
+		Use:   "docker [OPTIONS] COMMAND [ARG...]",
+		Short: "A self-sufficient runtime for containers",
+		Flags: []FlagInfo{
+			{Name: "config", Field: "Config", Type: "string", Default: "~/.docker", Usage: "Location of client config files"},
+			{Name: "context", Shorthand: "c", Field: "Context", Type: "string", Default: "", Usage: "Name of the context to use to connect to the daemon (overrides DOCKER_HOST env var and default context set with \"docker context use\")"},
+			{Name: "debug", Shorthand: "D", Field: "Debug", Type: "bool", Default: "false", Usage: "Enable debug mode"},
+			{Name: "host", Shorthand: "H", Field: "Host", Type: "list", Default: "", Usage: "Daemon socket(s) to connect to"},
+			{Name: "log-level", Shorthand: "l", Field: "LogLevel", Type: "string", Default: "info", Usage: "Set the logging level (\"debug\"|\"info\"|\"warn\"|\"error\"|\"fatal\")"},
+			{Name: "tls", Field: "Tls", Type: "bool", Default: "false", Usage: "Use TLS; implied by --tlsverify"},
+			{Name: "tlscacert", Field: "Tlscacert", Type: "string", Default: "", Usage: "Trust certs signed only by this CA"},
+			{Name: "tlscert", Field: "Tlscert", Type: "string", Default: "", Usage: "Path to TLS certificate file"},
+			{Name: "tlskey", Field: "Tlskey", Type: "string", Default: "", Usage: "Path to TLS key file"},
+			{Name: "tlsverify", Field: "Tlsverify", Type: "bool", Default: "false", Usage: "Use TLS and verify the remote"},
+			{Name: "version", Shorthand: "v", Field: "Version", Type: "bool", Default: "false", Usage: "Print version information and quit"},
+		},
+		option: func() interface{} { return &DockerOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCmd(*opt.(*DockerOption), args)
+		},
+	},
+	{
+		Name:  "DockerAttach",
+		Path:  []string{"docker", "attach"},
+		Use:   "attach [OPTIONS] CONTAINER",
+		Short: "Attach local standard input, output, and error streams to a running container",
+		Flags: []FlagInfo{
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "no-stdin", Field: "NoStdin", Type: "bool", Default: "false", Usage: "Do not attach STDIN"},
+			{Name: "sig-proxy", Field: "SigProxy", Type: "bool", Default: "true", Usage: "Proxy all received signals to the process"},
+		},
+		option: func() interface{} { return &DockerAttachOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerAttachCmd(*opt.(*DockerAttachOption), args)
+		},
+	},
+	{
+		Name:  "DockerBuild",
+		Path:  []string{"docker", "build"},
+		Use:   "build [OPTIONS] PATH | URL | -",
+		Short: "Build an image from a Dockerfile",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "build-arg", Field: "BuildArg", Type: "list", Default: "", Usage: "Set build-time variables"},
+			{Name: "cache-from", Field: "CacheFrom", Type: "stringSlice", Default: "[]", Usage: "Images to consider as cache sources"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "compress", Field: "Compress", Type: "bool", Default: "false", Usage: "Compress the build context using gzip", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) period", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) quota", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "file", Shorthand: "f", Field: "File", Type: "string", Default: "", Usage: "Name of the Dockerfile (Default is 'PATH/Dockerfile')"},
+			{Name: "force-rm", Field: "ForceRm", Type: "bool", Default: "false", Usage: "Always remove intermediate containers", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "iidfile", Field: "Iidfile", Type: "string", Default: "", Usage: "Write the image ID to the file"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "label", Field: "Label", Type: "list", Default: "", Usage: "Set metadata for an image"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "network", Field: "Network", Type: "string", Default: "default", Usage: "Set the networking mode for the RUN instructions during build", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "no-cache", Field: "NoCache", Type: "bool", Default: "false", Usage: "Do not use cache when building the image"},
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "stringArray", Default: "[]", Usage: "Output destination (format: type=local,dest=path)", Annotations: Annotations{MinAPIVersion: "1.40", BuildKit: true}},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+			{Name: "progress", Field: "Progress", Type: "string", Default: "auto", Usage: "Set type of progress output (auto, plain, tty). Use plain to show container output", Annotations: Annotations{BuildKit: true}},
+			{Name: "pull", Field: "Pull", Type: "bool", Default: "false", Usage: "Always attempt to pull a newer version of the image"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress the build output and print image ID on success"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "true", Usage: "Remove intermediate containers after a successful build", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "secret", Field: "Secret", Type: "stringArray", Default: "[]", Usage: "Secret file to expose to the build (only if BuildKit enabled): id=mysecret,src=/local/secret", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "stringSlice", Default: "[]", Usage: "Security options", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "squash", Field: "Squash", Type: "bool", Default: "false", Usage: "Squash newly built layers into a single new layer", Annotations: Annotations{MinAPIVersion: "1.25", Experimental: true}},
+			{Name: "ssh", Field: "Ssh", Type: "stringArray", Default: "[]", Usage: "SSH agent socket or keys to expose to the build (only if BuildKit enabled) (format: default|<id>[=<socket>|<key>[,<key>]])", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "stream", Field: "Stream", Type: "bool", Default: "false", Usage: "Stream attaches to server to negotiate build context", Deprecated: "the experimental --stream flag was removed in docker 20.10", Hidden: true},
+			{Name: "tag", Shorthand: "t", Field: "Tag", Type: "list", Default: "", Usage: "Name and optionally a tag in the 'name:tag' format"},
+			{Name: "target", Field: "Target", Type: "string", Default: "", Usage: "Set the target build stage to build."},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options", Annotations: Annotations{NoBuildKit: true}},
+		},
+		option: func() interface{} { return &DockerBuildOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerBuildCmd(*opt.(*DockerBuildOption), args)
+		},
+	},
+	{
+		Name:        "DockerBuilder",
+		Path:        []string{"docker", "builder"},
+		Use:         "builder",
+		Short:       "Manage builds",
+		Annotations: Annotations{MinAPIVersion: "1.31"},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerBuilderCmd(args)
+		},
+	},
+	{
+		Name:  "DockerBuilderBuild",
+		Path:  []string{"docker", "builder", "build"},
+		Use:   "build [OPTIONS] PATH | URL | -",
+		Short: "Build an image from a Dockerfile",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "build-arg", Field: "BuildArg", Type: "list", Default: "", Usage: "Set build-time variables"},
+			{Name: "cache-from", Field: "CacheFrom", Type: "stringSlice", Default: "[]", Usage: "Images to consider as cache sources"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "compress", Field: "Compress", Type: "bool", Default: "false", Usage: "Compress the build context using gzip", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) period", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) quota", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "file", Shorthand: "f", Field: "File", Type: "string", Default: "", Usage: "Name of the Dockerfile (Default is 'PATH/Dockerfile')"},
+			{Name: "force-rm", Field: "ForceRm", Type: "bool", Default: "false", Usage: "Always remove intermediate containers", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "iidfile", Field: "Iidfile", Type: "string", Default: "", Usage: "Write the image ID to the file"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "label", Field: "Label", Type: "list", Default: "", Usage: "Set metadata for an image"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "network", Field: "Network", Type: "string", Default: "default", Usage: "Set the networking mode for the RUN instructions during build", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "no-cache", Field: "NoCache", Type: "bool", Default: "false", Usage: "Do not use cache when building the image"},
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "stringArray", Default: "[]", Usage: "Output destination (format: type=local,dest=path)", Annotations: Annotations{MinAPIVersion: "1.40", BuildKit: true}},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+			{Name: "progress", Field: "Progress", Type: "string", Default: "auto", Usage: "Set type of progress output (auto, plain, tty). Use plain to show container output", Annotations: Annotations{BuildKit: true}},
+			{Name: "pull", Field: "Pull", Type: "bool", Default: "false", Usage: "Always attempt to pull a newer version of the image"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress the build output and print image ID on success"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "true", Usage: "Remove intermediate containers after a successful build", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "secret", Field: "Secret", Type: "stringArray", Default: "[]", Usage: "Secret file to expose to the build (only if BuildKit enabled): id=mysecret,src=/local/secret", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "stringSlice", Default: "[]", Usage: "Security options", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "squash", Field: "Squash", Type: "bool", Default: "false", Usage: "Squash newly built layers into a single new layer", Annotations: Annotations{MinAPIVersion: "1.25", Experimental: true}},
+			{Name: "ssh", Field: "Ssh", Type: "stringArray", Default: "[]", Usage: "SSH agent socket or keys to expose to the build (only if BuildKit enabled) (format: default|<id>[=<socket>|<key>[,<key>]])", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "stream", Field: "Stream", Type: "bool", Default: "false", Usage: "Stream attaches to server to negotiate build context", Deprecated: "the experimental --stream flag was removed in docker 20.10", Hidden: true},
+			{Name: "tag", Shorthand: "t", Field: "Tag", Type: "list", Default: "", Usage: "Name and optionally a tag in the 'name:tag' format"},
+			{Name: "target", Field: "Target", Type: "string", Default: "", Usage: "Set the target build stage to build."},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options", Annotations: Annotations{NoBuildKit: true}},
+		},
+		option: func() interface{} { return &DockerBuilderBuildOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerBuilderBuildCmd(*opt.(*DockerBuilderBuildOption), args)
+		},
+	},
+	{
+		Name:        "DockerBuilderPrune",
+		Path:        []string{"docker", "builder", "prune"},
+		Use:         "prune",
+		Short:       "Remove build cache",
+		Annotations: Annotations{MinAPIVersion: "1.39"},
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Remove all unused build cache, not just dangling ones"},
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'until=24h')"},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+			{Name: "keep-storage", Field: "KeepStorage", Type: "bytes", Default: "0", Usage: "Amount of disk space to keep for cache"},
+		},
+		option: func() interface{} { return &DockerBuilderPruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerBuilderPruneCmd(*opt.(*DockerBuilderPruneOption), args)
+		},
+	},
+	{
+		Name:        "DockerCheckpoint",
+		Path:        []string{"docker", "checkpoint"},
+		Use:         "checkpoint",
+		Short:       "Manage checkpoints",
+		Annotations: Annotations{MinAPIVersion: "1.25", OSType: "linux", Experimental: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerCheckpointCmd(args)
+		},
+	},
+	{
+		Name:  "DockerCheckpointCreate",
+		Path:  []string{"docker", "checkpoint", "create"},
+		Use:   "create [OPTIONS] CONTAINER CHECKPOINT",
+		Short: "Create a checkpoint from a running container",
+		Flags: []FlagInfo{
+			{Name: "checkpoint-dir", Field: "CheckpointDir", Type: "string", Default: "", Usage: "Use a custom checkpoint storage directory"},
+			{Name: "leave-running", Field: "LeaveRunning", Type: "bool", Default: "false", Usage: "Leave the container running after checkpoint"},
+		},
+		option: func() interface{} { return &DockerCheckpointCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCheckpointCreateCmd(*opt.(*DockerCheckpointCreateOption), args)
+		},
+	},
+	{
+		Name:    "DockerCheckpointLs",
+		Path:    []string{"docker", "checkpoint", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS] CONTAINER",
+		Short:   "List checkpoints for a container",
+		Flags: []FlagInfo{
+			{Name: "checkpoint-dir", Field: "CheckpointDir", Type: "string", Default: "", Usage: "Use a custom checkpoint storage directory"},
+		},
+		option: func() interface{} { return &DockerCheckpointLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCheckpointLsCmd(*opt.(*DockerCheckpointLsOption), args)
+		},
+	},
+	{
+		Name:    "DockerCheckpointRm",
+		Path:    []string{"docker", "checkpoint", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm [OPTIONS] CONTAINER CHECKPOINT",
+		Short:   "Remove a checkpoint",
+		Flags: []FlagInfo{
+			{Name: "checkpoint-dir", Field: "CheckpointDir", Type: "string", Default: "", Usage: "Use a custom checkpoint storage directory"},
+		},
+		option: func() interface{} { return &DockerCheckpointRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCheckpointRmCmd(*opt.(*DockerCheckpointRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerCommit",
+		Path:  []string{"docker", "commit"},
+		Use:   "commit [OPTIONS] CONTAINER [REPOSITORY[:TAG]]",
+		Short: "Create a new image from a container's changes",
+		Flags: []FlagInfo{
+			{Name: "author", Shorthand: "a", Field: "Author", Type: "string", Default: "", Usage: "Author (e.g., \"John Hannibal Smith <hannibal@a-team.com>\")"},
+			{Name: "change", Shorthand: "c", Field: "Change", Type: "list", Default: "", Usage: "Apply Dockerfile instruction to the created image"},
+			{Name: "message", Shorthand: "m", Field: "Message", Type: "string", Default: "", Usage: "Commit message"},
+			{Name: "pause", Shorthand: "p", Field: "Pause", Type: "bool", Default: "true", Usage: "Pause container during commit"},
+		},
+		option: func() interface{} { return &DockerCommitOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCommitCmd(*opt.(*DockerCommitOption), args)
+		},
+	},
+	{
+		Name:        "DockerConfig",
+		Path:        []string{"docker", "config"},
+		Use:         "config",
+		Short:       "Manage Docker configs",
+		Annotations: Annotations{MinAPIVersion: "1.30", Swarm: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerConfigCmd(args)
+		},
+	},
+	{
+		Name:  "DockerConfigCreate",
+		Path:  []string{"docker", "config", "create"},
+		Use:   "create [OPTIONS] CONFIG file|-",
+		Short: "Create a config from a file or STDIN",
+		Flags: []FlagInfo{
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Config labels"},
+			{Name: "template-driver", Field: "TemplateDriver", Type: "string", Default: "", Usage: "Template driver", Annotations: Annotations{MinAPIVersion: "1.37"}},
+		},
+		option: func() interface{} { return &DockerConfigCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerConfigCreateCmd(*opt.(*DockerConfigCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerConfigInspect",
+		Path:  []string{"docker", "config", "inspect"},
+		Use:   "inspect [OPTIONS] CONFIG [CONFIG...]",
+		Short: "Display detailed information on one or more configs",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "pretty", Field: "Pretty", Type: "bool", Default: "false", Usage: "Print the information in a human friendly format"},
+		},
+		option: func() interface{} { return &DockerConfigInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerConfigInspectCmd(*opt.(*DockerConfigInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerConfigLs",
+		Path:    []string{"docker", "config", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List configs",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print configs using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display IDs"},
+		},
+		option: func() interface{} { return &DockerConfigLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerConfigLsCmd(*opt.(*DockerConfigLsOption), args)
+		},
+	},
+	{
+		Name:    "DockerConfigRm",
+		Path:    []string{"docker", "config", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm CONFIG [CONFIG...]",
+		Short:   "Remove one or more configs",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerConfigRmCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainer",
+		Path:  []string{"docker", "container"},
+		Use:   "container",
+		Short: "Manage containers",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerAttach",
+		Path:  []string{"docker", "container", "attach"},
+		Use:   "attach [OPTIONS] CONTAINER",
+		Short: "Attach local standard input, output, and error streams to a running container",
+		Flags: []FlagInfo{
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "no-stdin", Field: "NoStdin", Type: "bool", Default: "false", Usage: "Do not attach STDIN"},
+			{Name: "sig-proxy", Field: "SigProxy", Type: "bool", Default: "true", Usage: "Proxy all received signals to the process"},
+		},
+		option: func() interface{} { return &DockerContainerAttachOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerAttachCmd(*opt.(*DockerContainerAttachOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerCommit",
+		Path:  []string{"docker", "container", "commit"},
+		Use:   "commit [OPTIONS] CONTAINER [REPOSITORY[:TAG]]",
+		Short: "Create a new image from a container's changes",
+		Flags: []FlagInfo{
+			{Name: "author", Shorthand: "a", Field: "Author", Type: "string", Default: "", Usage: "Author (e.g., \"John Hannibal Smith <hannibal@a-team.com>\")"},
+			{Name: "change", Shorthand: "c", Field: "Change", Type: "list", Default: "", Usage: "Apply Dockerfile instruction to the created image"},
+			{Name: "message", Shorthand: "m", Field: "Message", Type: "string", Default: "", Usage: "Commit message"},
+			{Name: "pause", Shorthand: "p", Field: "Pause", Type: "bool", Default: "true", Usage: "Pause container during commit"},
+		},
+		option: func() interface{} { return &DockerContainerCommitOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerCommitCmd(*opt.(*DockerContainerCommitOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerCp",
+		Path:  []string{"docker", "container", "cp"},
+		Use:   "cp [OPTIONS] CONTAINER:SRC_PATH DEST_PATH|-\n\n\tdocker cp [OPTIONS] SRC_PATH|- CONTAINER:DEST_PATH\n",
+		Short: "Copy files/folders between a container and the local filesystem",
+		Flags: []FlagInfo{
+			{Name: "archive", Shorthand: "a", Field: "Archive", Type: "bool", Default: "false", Usage: "Archive mode (copy all uid/gid information)"},
+			{Name: "follow-link", Shorthand: "L", Field: "FollowLink", Type: "bool", Default: "false", Usage: "Always follow symbol link in SRC_PATH"},
+		},
+		option: func() interface{} { return &DockerContainerCpOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerCpCmd(*opt.(*DockerContainerCpOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerCreate",
+		Path:  []string{"docker", "container", "create"},
+		Use:   "create [OPTIONS] IMAGE [COMMAND] [ARG...]",
+		Short: "Create a new container",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "list", Default: "", Usage: "Attach to STDIN, STDOUT or STDERR"},
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "blkio-weight-device", Field: "BlkioWeightDevice", Type: "list", Default: "[]", Usage: "Block IO weight (relative device weight)"},
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities"},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container"},
+			{Name: "cgroupns", Field: "Cgroupns", Type: "string", Default: "", Usage: "\tCgroup namespace to use (host|private)\n'host':    Run the container in the Docker host's cgroup namespace\n'private': Run the container in its own private cgroup namespace\n'':        Use the cgroup namespace as configured by the\n           default-cgroupns-mode option on the daemon (default)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cidfile", Field: "Cidfile", Type: "string", Default: "", Usage: "Write the container ID to the file"},
+			{Name: "cpu-count", Field: "CpuCount", Type: "int64", Default: "0", Usage: "CPU count (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-percent", Field: "CpuPercent", Type: "int64", Default: "0", Usage: "CPU percent (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "device", Field: "Device", Type: "list", Default: "", Usage: "Add a host device to the container"},
+			{Name: "device-cgroup-rule", Field: "DeviceCgroupRule", Type: "list", Default: "", Usage: "Add a rule to the cgroup allowed devices list"},
+			{Name: "device-read-bps", Field: "DeviceReadBps", Type: "list", Default: "[]", Usage: "Limit read rate (bytes per second) from a device"},
+			{Name: "device-read-iops", Field: "DeviceReadIops", Type: "list", Default: "[]", Usage: "Limit read rate (IO per second) from a device"},
+			{Name: "device-write-bps", Field: "DeviceWriteBps", Type: "list", Default: "[]", Usage: "Limit write rate (bytes per second) to a device"},
+			{Name: "device-write-iops", Field: "DeviceWriteIops", Type: "list", Default: "[]", Usage: "Limit write rate (IO per second) to a device"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "dns", Field: "Dns", Type: "list", Default: "", Usage: "Set custom DNS servers"},
+			{Name: "dns-opt", Field: "DnsOpt", Type: "list", Default: "", Usage: "Set DNS options", Hidden: true},
+			{Name: "dns-option", Field: "DnsOption", Type: "list", Default: "", Usage: "Set DNS options"},
+			{Name: "dns-search", Field: "DnsSearch", Type: "list", Default: "", Usage: "Set custom DNS search domains"},
+			{Name: "domainname", Field: "Domainname", Type: "string", Default: "", Usage: "Container NIS domain name"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "string", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables"},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables"},
+			{Name: "expose", Field: "Expose", Type: "list", Default: "", Usage: "Expose a port or a range of ports"},
+			{Name: "gpus", Field: "Gpus", Type: "gpu-request", Default: "", Usage: "GPU devices to add to the container ('all' to pass all GPUs)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "group-add", Field: "GroupAdd", Type: "list", Default: "", Usage: "Add additional groups to join"},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health"},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "0s", Usage: "Time between running the check (ms|s|m|h) (default 0s)"},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy"},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "0s", Usage: "Start period for the container to initialize before starting health-retries countdown (ms|s|m|h) (default 0s)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "0s", Usage: "Maximum time to allow one check to run (ms|s|m|h) (default 0s)"},
+			{Name: "help", Field: "Help", Type: "bool", Default: "false", Usage: "Print usage"},
+			{Name: "hostname", Shorthand: "h", Field: "Hostname", Type: "string", Default: "", Usage: "Container host name"},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Run an init inside the container that forwards signals and reaps processes", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "io-maxbandwidth", Field: "IoMaxbandwidth", Type: "bytes", Default: "0", Usage: "Maximum IO bandwidth limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "io-maxiops", Field: "IoMaxiops", Type: "uint64", Default: "0", Usage: "Maximum IOps limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "ip", Field: "Ip", Type: "string", Default: "", Usage: "IPv4 address (e.g., 172.30.100.104)"},
+			{Name: "ip6", Field: "Ip6", Type: "string", Default: "", Usage: "IPv6 address (e.g., 2001:db8::33)"},
+			{Name: "ipc", Field: "Ipc", Type: "string", Default: "", Usage: "IPC mode to use"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Set meta data on a container"},
+			{Name: "label-file", Field: "LabelFile", Type: "list", Default: "", Usage: "Read in a line delimited file of labels"},
+			{Name: "link", Field: "Link", Type: "list", Default: "", Usage: "Add link to another container"},
+			{Name: "link-local-ip", Field: "LinkLocalIp", Type: "list", Default: "", Usage: "Container IPv4/IPv6 link-local addresses"},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for the container"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Log driver options"},
+			{Name: "mac-address", Field: "MacAddress", Type: "string", Default: "", Usage: "Container MAC address (e.g., 92:d0:c6:0a:29:33)"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "memory-swappiness", Field: "MemorySwappiness", Type: "int64", Default: "-1", Usage: "Tune container memory swappiness (0 to 100)"},
+			{Name: "mount", Field: "Mount", Type: "mount", Default: "", Usage: "Attach a filesystem mount to the container"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Assign a name to the container"},
+			{Name: "net", Field: "Net", Type: "network", Default: "", Usage: "Connect a container to a network", Hidden: true},
+			{Name: "net-alias", Field: "NetAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container", Hidden: true},
+			{Name: "network", Field: "Network", Type: "network", Default: "", Usage: "Connect a container to a network"},
+			{Name: "network-alias", Field: "NetworkAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container"},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK"},
+			{Name: "oom-kill-disable", Field: "OomKillDisable", Type: "bool", Default: "false", Usage: "Disable OOM Killer"},
+			{Name: "oom-score-adj", Field: "OomScoreAdj", Type: "int", Default: "0", Usage: "Tune host's OOM preferences (-1000 to 1000)"},
+			{Name: "pid", Field: "Pid", Type: "string", Default: "", Usage: "PID namespace to use"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.32"}},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to this container"},
+			{Name: "publish", Shorthand: "p", Field: "Publish", Type: "list", Default: "", Usage: "Publish a container's port(s) to the host"},
+			{Name: "publish-all", Shorthand: "P", Field: "PublishAll", Type: "bool", Default: "false", Usage: "Publish all exposed ports to random ports"},
+			{Name: "pull", Field: "Pull", Type: "string", Default: "missing", Usage: "Pull image before creating (\"always\"|\"missing\"|\"never\")"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only"},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "no", Usage: "Restart policy to apply when a container exits"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "false", Usage: "Automatically remove the container when it exits"},
+			{Name: "runtime", Field: "Runtime", Type: "string", Default: "", Usage: "Runtime to use for this container"},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "list", Default: "", Usage: "Security Options"},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "SIGTERM", Usage: "Signal to stop a container"},
+			{Name: "stop-timeout", Field: "StopTimeout", Type: "int", Default: "0", Usage: "Timeout (in seconds) to stop a container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "storage-opt", Field: "StorageOpt", Type: "list", Default: "", Usage: "Storage driver options for the container"},
+			{Name: "sysctl", Field: "Sysctl", Type: "map", Default: "map[]", Usage: "Sysctl options"},
+			{Name: "tmpfs", Field: "Tmpfs", Type: "list", Default: "", Usage: "Mount a tmpfs directory"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "userns", Field: "Userns", Type: "string", Default: "", Usage: "User namespace to use"},
+			{Name: "uts", Field: "Uts", Type: "string", Default: "", Usage: "UTS namespace to use"},
+			{Name: "volume", Shorthand: "v", Field: "Volume", Type: "list", Default: "", Usage: "Bind mount a volume"},
+			{Name: "volume-driver", Field: "VolumeDriver", Type: "string", Default: "", Usage: "Optional volume driver for the container"},
+			{Name: "volumes-from", Field: "VolumesFrom", Type: "list", Default: "", Usage: "Mount volumes from the specified container(s)"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerContainerCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerCreateCmd(*opt.(*DockerContainerCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerDiff",
+		Path:  []string{"docker", "container", "diff"},
+		Use:   "diff CONTAINER",
+		Short: "Inspect changes to files or directories on a container's filesystem",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerDiffCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerExec",
+		Path:  []string{"docker", "container", "exec"},
+		Use:   "exec [OPTIONS] CONTAINER COMMAND [ARG...]",
+		Short: "Run a command in a running container",
+		Flags: []FlagInfo{
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Detached mode: run command in the background"},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to the command"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container", Annotations: Annotations{MinAPIVersion: "1.35"}},
+		},
+		option: func() interface{} { return &DockerContainerExecOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerExecCmd(*opt.(*DockerContainerExecOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerExport",
+		Path:  []string{"docker", "container", "export"},
+		Use:   "export [OPTIONS] CONTAINER",
+		Short: "Export a container's filesystem as a tar archive",
+		Flags: []FlagInfo{
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "string", Default: "", Usage: "Write to a file, instead of STDOUT"},
+		},
+		option: func() interface{} { return &DockerContainerExportOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerExportCmd(*opt.(*DockerContainerExportOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerInspect",
+		Path:  []string{"docker", "container", "inspect"},
+		Use:   "inspect [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Display detailed information on one or more containers",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "size", Shorthand: "s", Field: "Size", Type: "bool", Default: "false", Usage: "Display total file sizes"},
+		},
+		option: func() interface{} { return &DockerContainerInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerInspectCmd(*opt.(*DockerContainerInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerKill",
+		Path:  []string{"docker", "container", "kill"},
+		Use:   "kill [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Kill one or more running containers",
+		Flags: []FlagInfo{
+			{Name: "signal", Shorthand: "s", Field: "Signal", Type: "string", Default: "KILL", Usage: "Signal to send to the container"},
+		},
+		option: func() interface{} { return &DockerContainerKillOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerKillCmd(*opt.(*DockerContainerKillOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerLogs",
+		Path:  []string{"docker", "container", "logs"},
+		Use:   "logs [OPTIONS] CONTAINER",
+		Short: "Fetch the logs of a container",
+		Flags: []FlagInfo{
+			{Name: "details", Field: "Details", Type: "bool", Default: "false", Usage: "Show extra details provided to logs"},
+			{Name: "follow", Shorthand: "f", Field: "Follow", Type: "bool", Default: "false", Usage: "Follow log output"},
+			{Name: "since", Field: "Since", Type: "string", Default: "", Usage: "Show logs since timestamp (e.g. 2013-01-02T13:23:37Z) or relative (e.g. 42m for 42 minutes)"},
+			{Name: "tail", Shorthand: "n", Field: "Tail", Type: "string", Default: "all", Usage: "Number of lines to show from the end of the logs"},
+			{Name: "timestamps", Shorthand: "t", Field: "Timestamps", Type: "bool", Default: "false", Usage: "Show timestamps"},
+			{Name: "until", Field: "Until", Type: "string", Default: "", Usage: "Show logs before a timestamp (e.g. 2013-01-02T13:23:37Z) or relative (e.g. 42m for 42 minutes)", Annotations: Annotations{MinAPIVersion: "1.35"}},
+		},
+		option: func() interface{} { return &DockerContainerLogsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerLogsCmd(*opt.(*DockerContainerLogsOption), args)
+		},
+	},
+	{
+		Name:    "DockerContainerLs",
+		Path:    []string{"docker", "container", "ls"},
+		Aliases: []string{"ps", "list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List containers",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all containers (default shows just running)"},
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print containers using a Go template"},
+			{Name: "last", Shorthand: "n", Field: "Last", Type: "int", Default: "-1", Usage: "Show n last created containers (includes all states)"},
+			{Name: "latest", Shorthand: "l", Field: "Latest", Type: "bool", Default: "false", Usage: "Show the latest created container (includes all states)"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display container IDs"},
+			{Name: "size", Shorthand: "s", Field: "Size", Type: "bool", Default: "false", Usage: "Display total file sizes"},
+		},
+		option: func() interface{} { return &DockerContainerLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerLsCmd(*opt.(*DockerContainerLsOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerPause",
+		Path:  []string{"docker", "container", "pause"},
+		Use:   "pause CONTAINER [CONTAINER...]",
+		Short: "Pause all processes within one or more containers",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerPauseCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerPort",
+		Path:  []string{"docker", "container", "port"},
+		Use:   "port CONTAINER [PRIVATE_PORT[/PROTO]]",
+		Short: "List port mappings or a specific mapping for the container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerPortCmd(args)
+		},
+	},
+	{
+		Name:        "DockerContainerPrune",
+		Path:        []string{"docker", "container", "prune"},
+		Use:         "prune [OPTIONS]",
+		Short:       "Remove all stopped containers",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'until=<timestamp>')"},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+		},
+		option: func() interface{} { return &DockerContainerPruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerPruneCmd(*opt.(*DockerContainerPruneOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerRename",
+		Path:  []string{"docker", "container", "rename"},
+		Use:   "rename CONTAINER NEW_NAME",
+		Short: "Rename a container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerRenameCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerRestart",
+		Path:  []string{"docker", "container", "restart"},
+		Use:   "restart [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Restart one or more containers",
+		Flags: []FlagInfo{
+			{Name: "time", Shorthand: "t", Field: "Time", Type: "int", Default: "10", Usage: "Seconds to wait for stop before killing the container"},
+		},
+		option: func() interface{} { return &DockerContainerRestartOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerRestartCmd(*opt.(*DockerContainerRestartOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerRm",
+		Path:  []string{"docker", "container", "rm"},
+		Use:   "rm [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Remove one or more containers",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the removal of a running container (uses SIGKILL)"},
+			{Name: "link", Shorthand: "l", Field: "Link", Type: "bool", Default: "false", Usage: "Remove the specified link"},
+			{Name: "volumes", Shorthand: "v", Field: "Volumes", Type: "bool", Default: "false", Usage: "Remove anonymous volumes associated with the container"},
+		},
+		option: func() interface{} { return &DockerContainerRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerRmCmd(*opt.(*DockerContainerRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerRun",
+		Path:  []string{"docker", "container", "run"},
+		Use:   "run [OPTIONS] IMAGE [COMMAND] [ARG...]",
+		Short: "Run a command in a new container",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "list", Default: "", Usage: "Attach to STDIN, STDOUT or STDERR"},
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "blkio-weight-device", Field: "BlkioWeightDevice", Type: "list", Default: "[]", Usage: "Block IO weight (relative device weight)"},
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities"},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container"},
+			{Name: "cgroupns", Field: "Cgroupns", Type: "string", Default: "", Usage: "\tCgroup namespace to use (host|private)\n'host':    Run the container in the Docker host's cgroup namespace\n'private': Run the container in its own private cgroup namespace\n'':        Use the cgroup namespace as configured by the\n           default-cgroupns-mode option on the daemon (default)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cidfile", Field: "Cidfile", Type: "string", Default: "", Usage: "Write the container ID to the file"},
+			{Name: "cpu-count", Field: "CpuCount", Type: "int64", Default: "0", Usage: "CPU count (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-percent", Field: "CpuPercent", Type: "int64", Default: "0", Usage: "CPU percent (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Run container in background and print container ID"},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "device", Field: "Device", Type: "list", Default: "", Usage: "Add a host device to the container"},
+			{Name: "device-cgroup-rule", Field: "DeviceCgroupRule", Type: "list", Default: "", Usage: "Add a rule to the cgroup allowed devices list"},
+			{Name: "device-read-bps", Field: "DeviceReadBps", Type: "list", Default: "[]", Usage: "Limit read rate (bytes per second) from a device"},
+			{Name: "device-read-iops", Field: "DeviceReadIops", Type: "list", Default: "[]", Usage: "Limit read rate (IO per second) from a device"},
+			{Name: "device-write-bps", Field: "DeviceWriteBps", Type: "list", Default: "[]", Usage: "Limit write rate (bytes per second) to a device"},
+			{Name: "device-write-iops", Field: "DeviceWriteIops", Type: "list", Default: "[]", Usage: "Limit write rate (IO per second) to a device"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "dns", Field: "Dns", Type: "list", Default: "", Usage: "Set custom DNS servers"},
+			{Name: "dns-opt", Field: "DnsOpt", Type: "list", Default: "", Usage: "Set DNS options", Hidden: true},
+			{Name: "dns-option", Field: "DnsOption", Type: "list", Default: "", Usage: "Set DNS options"},
+			{Name: "dns-search", Field: "DnsSearch", Type: "list", Default: "", Usage: "Set custom DNS search domains"},
+			{Name: "domainname", Field: "Domainname", Type: "string", Default: "", Usage: "Container NIS domain name"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "string", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables"},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables"},
+			{Name: "expose", Field: "Expose", Type: "list", Default: "", Usage: "Expose a port or a range of ports"},
+			{Name: "gpus", Field: "Gpus", Type: "gpu-request", Default: "", Usage: "GPU devices to add to the container ('all' to pass all GPUs)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "group-add", Field: "GroupAdd", Type: "list", Default: "", Usage: "Add additional groups to join"},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health"},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "0s", Usage: "Time between running the check (ms|s|m|h) (default 0s)"},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy"},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "0s", Usage: "Start period for the container to initialize before starting health-retries countdown (ms|s|m|h) (default 0s)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "0s", Usage: "Maximum time to allow one check to run (ms|s|m|h) (default 0s)"},
+			{Name: "help", Field: "Help", Type: "bool", Default: "false", Usage: "Print usage"},
+			{Name: "hostname", Shorthand: "h", Field: "Hostname", Type: "string", Default: "", Usage: "Container host name"},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Run an init inside the container that forwards signals and reaps processes", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "io-maxbandwidth", Field: "IoMaxbandwidth", Type: "bytes", Default: "0", Usage: "Maximum IO bandwidth limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "io-maxiops", Field: "IoMaxiops", Type: "uint64", Default: "0", Usage: "Maximum IOps limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "ip", Field: "Ip", Type: "string", Default: "", Usage: "IPv4 address (e.g., 172.30.100.104)"},
+			{Name: "ip6", Field: "Ip6", Type: "string", Default: "", Usage: "IPv6 address (e.g., 2001:db8::33)"},
+			{Name: "ipc", Field: "Ipc", Type: "string", Default: "", Usage: "IPC mode to use"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Set meta data on a container"},
+			{Name: "label-file", Field: "LabelFile", Type: "list", Default: "", Usage: "Read in a line delimited file of labels"},
+			{Name: "link", Field: "Link", Type: "list", Default: "", Usage: "Add link to another container"},
+			{Name: "link-local-ip", Field: "LinkLocalIp", Type: "list", Default: "", Usage: "Container IPv4/IPv6 link-local addresses"},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for the container"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Log driver options"},
+			{Name: "mac-address", Field: "MacAddress", Type: "string", Default: "", Usage: "Container MAC address (e.g., 92:d0:c6:0a:29:33)"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "memory-swappiness", Field: "MemorySwappiness", Type: "int64", Default: "-1", Usage: "Tune container memory swappiness (0 to 100)"},
+			{Name: "mount", Field: "Mount", Type: "mount", Default: "", Usage: "Attach a filesystem mount to the container"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Assign a name to the container"},
+			{Name: "net", Field: "Net", Type: "network", Default: "", Usage: "Connect a container to a network", Hidden: true},
+			{Name: "net-alias", Field: "NetAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container", Hidden: true},
+			{Name: "network", Field: "Network", Type: "network", Default: "", Usage: "Connect a container to a network"},
+			{Name: "network-alias", Field: "NetworkAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container"},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK"},
+			{Name: "oom-kill-disable", Field: "OomKillDisable", Type: "bool", Default: "false", Usage: "Disable OOM Killer"},
+			{Name: "oom-score-adj", Field: "OomScoreAdj", Type: "int", Default: "0", Usage: "Tune host's OOM preferences (-1000 to 1000)"},
+			{Name: "pid", Field: "Pid", Type: "string", Default: "", Usage: "PID namespace to use"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.32"}},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to this container"},
+			{Name: "publish", Shorthand: "p", Field: "Publish", Type: "list", Default: "", Usage: "Publish a container's port(s) to the host"},
+			{Name: "publish-all", Shorthand: "P", Field: "PublishAll", Type: "bool", Default: "false", Usage: "Publish all exposed ports to random ports"},
+			{Name: "pull", Field: "Pull", Type: "string", Default: "missing", Usage: "Pull image before running (\"always\"|\"missing\"|\"never\")"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only"},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "no", Usage: "Restart policy to apply when a container exits"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "false", Usage: "Automatically remove the container when it exits"},
+			{Name: "runtime", Field: "Runtime", Type: "string", Default: "", Usage: "Runtime to use for this container"},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "list", Default: "", Usage: "Security Options"},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm"},
+			{Name: "sig-proxy", Field: "SigProxy", Type: "bool", Default: "true", Usage: "Proxy received signals to the process"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "SIGTERM", Usage: "Signal to stop a container"},
+			{Name: "stop-timeout", Field: "StopTimeout", Type: "int", Default: "0", Usage: "Timeout (in seconds) to stop a container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "storage-opt", Field: "StorageOpt", Type: "list", Default: "", Usage: "Storage driver options for the container"},
+			{Name: "sysctl", Field: "Sysctl", Type: "map", Default: "map[]", Usage: "Sysctl options"},
+			{Name: "tmpfs", Field: "Tmpfs", Type: "list", Default: "", Usage: "Mount a tmpfs directory"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "userns", Field: "Userns", Type: "string", Default: "", Usage: "User namespace to use"},
+			{Name: "uts", Field: "Uts", Type: "string", Default: "", Usage: "UTS namespace to use"},
+			{Name: "volume", Shorthand: "v", Field: "Volume", Type: "list", Default: "", Usage: "Bind mount a volume"},
+			{Name: "volume-driver", Field: "VolumeDriver", Type: "string", Default: "", Usage: "Optional volume driver for the container"},
+			{Name: "volumes-from", Field: "VolumesFrom", Type: "list", Default: "", Usage: "Mount volumes from the specified container(s)"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerContainerRunOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerRunCmd(*opt.(*DockerContainerRunOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerStart",
+		Path:  []string{"docker", "container", "start"},
+		Use:   "start [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Start one or more stopped containers",
+		Flags: []FlagInfo{
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "bool", Default: "false", Usage: "Attach STDOUT/STDERR and forward signals"},
+			{Name: "checkpoint", Field: "Checkpoint", Type: "string", Default: "", Usage: "Restore from this checkpoint", Annotations: Annotations{OSType: "linux", Experimental: true}},
+			{Name: "checkpoint-dir", Field: "CheckpointDir", Type: "string", Default: "", Usage: "Use a custom checkpoint storage directory", Annotations: Annotations{OSType: "linux", Experimental: true}},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Attach container's STDIN"},
+		},
+		option: func() interface{} { return &DockerContainerStartOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerStartCmd(*opt.(*DockerContainerStartOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerStats",
+		Path:  []string{"docker", "container", "stats"},
+		Use:   "stats [OPTIONS] [CONTAINER...]",
+		Short: "Display a live stream of container(s) resource usage statistics",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all containers (default shows just running)"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "no-stream", Field: "NoStream", Type: "bool", Default: "false", Usage: "Disable streaming stats and only pull the first result"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+		},
+		option: func() interface{} { return &DockerContainerStatsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerStatsCmd(*opt.(*DockerContainerStatsOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerStop",
+		Path:  []string{"docker", "container", "stop"},
+		Use:   "stop [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Stop one or more running containers",
+		Flags: []FlagInfo{
+			{Name: "time", Shorthand: "t", Field: "Time", Type: "int", Default: "10", Usage: "Seconds to wait for stop before killing it"},
+		},
+		option: func() interface{} { return &DockerContainerStopOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerStopCmd(*opt.(*DockerContainerStopOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerTop",
+		Path:  []string{"docker", "container", "top"},
+		Use:   "top CONTAINER [ps OPTIONS]",
+		Short: "Display the running processes of a container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerTopCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerUnpause",
+		Path:  []string{"docker", "container", "unpause"},
+		Use:   "unpause CONTAINER [CONTAINER...]",
+		Short: "Unpause all processes within one or more containers",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerUnpauseCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContainerUpdate",
+		Path:  []string{"docker", "container", "update"},
+		Use:   "update [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Update configuration of one or more containers",
+		Flags: []FlagInfo{
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit the CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit the CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "", Usage: "Restart policy to apply when a container exits"},
+		},
+		option: func() interface{} { return &DockerContainerUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContainerUpdateCmd(*opt.(*DockerContainerUpdateOption), args)
+		},
+	},
+	{
+		Name:  "DockerContainerWait",
+		Path:  []string{"docker", "container", "wait"},
+		Use:   "wait CONTAINER [CONTAINER...]",
+		Short: "Block until one or more containers stop, then print their exit codes",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContainerWaitCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContext",
+		Path:  []string{"docker", "context"},
+		Use:   "context",
+		Short: "Manage contexts",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContextCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContextCreate",
+		Path:  []string{"docker", "context", "create"},
+		Use:   "create [OPTIONS] CONTEXT",
+		Short: "Create a context",
+		Flags: []FlagInfo{
+			{Name: "default-stack-orchestrator", Field: "DefaultStackOrchestrator", Type: "string", Default: "", Usage: "Default orchestrator for stack operations to use with this context (swarm|kubernetes|all)", Deprecated: "Kubernetes stack and context support is deprecated"},
+			{Name: "description", Field: "Description", Type: "string", Default: "", Usage: "Description of the context"},
+			{Name: "docker", Field: "Docker", Type: "stringToString", Default: "[]", Usage: "set the docker endpoint"},
+			{Name: "from", Field: "From", Type: "string", Default: "", Usage: "create context from a named context"},
+			{Name: "kubernetes", Field: "Kubernetes", Type: "stringToString", Default: "[]", Usage: "set the kubernetes endpoint", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerContextCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextCreateCmd(*opt.(*DockerContextCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerContextExport",
+		Path:  []string{"docker", "context", "export"},
+		Use:   "export [OPTIONS] CONTEXT [FILE|-]",
+		Short: "Export a context to a tar or kubeconfig file",
+		Flags: []FlagInfo{
+			{Name: "kubeconfig", Field: "Kubeconfig", Type: "bool", Default: "false", Usage: "Export as a kubeconfig file", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerContextExportOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextExportCmd(*opt.(*DockerContextExportOption), args)
+		},
+	},
+	{
+		Name:  "DockerContextImport",
+		Path:  []string{"docker", "context", "import"},
+		Use:   "import CONTEXT FILE|-",
+		Short: "Import a context from a tar or zip file",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContextImportCmd(args)
+		},
+	},
+	{
+		Name:  "DockerContextInspect",
+		Path:  []string{"docker", "context", "inspect"},
+		Use:   "inspect [OPTIONS] [CONTEXT] [CONTEXT...]",
+		Short: "Display detailed information on one or more contexts",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerContextInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextInspectCmd(*opt.(*DockerContextInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerContextLs",
+		Path:    []string{"docker", "context", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List contexts",
+		Flags: []FlagInfo{
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print contexts using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only show context names"},
+		},
+		option: func() interface{} { return &DockerContextLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextLsCmd(*opt.(*DockerContextLsOption), args)
+		},
+	},
+	{
+		Name:    "DockerContextRm",
+		Path:    []string{"docker", "context", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm CONTEXT [CONTEXT...]",
+		Short:   "Remove one or more contexts",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the removal of a context in use"},
+		},
+		option: func() interface{} { return &DockerContextRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextRmCmd(*opt.(*DockerContextRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerContextUpdate",
+		Path:  []string{"docker", "context", "update"},
+		Use:   "update [OPTIONS] CONTEXT",
+		Short: "Update a context",
+		Flags: []FlagInfo{
+			{Name: "default-stack-orchestrator", Field: "DefaultStackOrchestrator", Type: "string", Default: "", Usage: "Default orchestrator for stack operations to use with this context (swarm|kubernetes|all)", Deprecated: "Kubernetes stack and context support is deprecated"},
+			{Name: "description", Field: "Description", Type: "string", Default: "", Usage: "Description of the context"},
+			{Name: "docker", Field: "Docker", Type: "stringToString", Default: "[]", Usage: "set the docker endpoint"},
+			{Name: "kubernetes", Field: "Kubernetes", Type: "stringToString", Default: "[]", Usage: "set the kubernetes endpoint", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerContextUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerContextUpdateCmd(*opt.(*DockerContextUpdateOption), args)
+		},
+	},
+	{
+		Name:  "DockerContextUse",
+		Path:  []string{"docker", "context", "use"},
+		Use:   "use CONTEXT",
+		Short: "Set the current docker context",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerContextUseCmd(args)
+		},
+	},
+	{
+		Name:  "DockerCp",
+		Path:  []string{"docker", "cp"},
+		Use:   "cp [OPTIONS] CONTAINER:SRC_PATH DEST_PATH|-\n\n\tdocker cp [OPTIONS] SRC_PATH|- CONTAINER:DEST_PATH\n",
+		Short: "Copy files/folders between a container and the local filesystem",
+		Flags: []FlagInfo{
+			{Name: "archive", Shorthand: "a", Field: "Archive", Type: "bool", Default: "false", Usage: "Archive mode (copy all uid/gid information)"},
+			{Name: "follow-link", Shorthand: "L", Field: "FollowLink", Type: "bool", Default: "false", Usage: "Always follow symbol link in SRC_PATH"},
+		},
+		option: func() interface{} { return &DockerCpOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCpCmd(*opt.(*DockerCpOption), args)
+		},
+	},
+	{
+		Name:  "DockerCreate",
+		Path:  []string{"docker", "create"},
+		Use:   "create [OPTIONS] IMAGE [COMMAND] [ARG...]",
+		Short: "Create a new container",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "list", Default: "", Usage: "Attach to STDIN, STDOUT or STDERR"},
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "blkio-weight-device", Field: "BlkioWeightDevice", Type: "list", Default: "[]", Usage: "Block IO weight (relative device weight)"},
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities"},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container"},
+			{Name: "cgroupns", Field: "Cgroupns", Type: "string", Default: "", Usage: "\tCgroup namespace to use (host|private)\n'host':    Run the container in the Docker host's cgroup namespace\n'private': Run the container in its own private cgroup namespace\n'':        Use the cgroup namespace as configured by the\n           default-cgroupns-mode option on the daemon (default)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cidfile", Field: "Cidfile", Type: "string", Default: "", Usage: "Write the container ID to the file"},
+			{Name: "cpu-count", Field: "CpuCount", Type: "int64", Default: "0", Usage: "CPU count (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-percent", Field: "CpuPercent", Type: "int64", Default: "0", Usage: "CPU percent (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "device", Field: "Device", Type: "list", Default: "", Usage: "Add a host device to the container"},
+			{Name: "device-cgroup-rule", Field: "DeviceCgroupRule", Type: "list", Default: "", Usage: "Add a rule to the cgroup allowed devices list"},
+			{Name: "device-read-bps", Field: "DeviceReadBps", Type: "list", Default: "[]", Usage: "Limit read rate (bytes per second) from a device"},
+			{Name: "device-read-iops", Field: "DeviceReadIops", Type: "list", Default: "[]", Usage: "Limit read rate (IO per second) from a device"},
+			{Name: "device-write-bps", Field: "DeviceWriteBps", Type: "list", Default: "[]", Usage: "Limit write rate (bytes per second) to a device"},
+			{Name: "device-write-iops", Field: "DeviceWriteIops", Type: "list", Default: "[]", Usage: "Limit write rate (IO per second) to a device"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "dns", Field: "Dns", Type: "list", Default: "", Usage: "Set custom DNS servers"},
+			{Name: "dns-opt", Field: "DnsOpt", Type: "list", Default: "", Usage: "Set DNS options", Hidden: true},
+			{Name: "dns-option", Field: "DnsOption", Type: "list", Default: "", Usage: "Set DNS options"},
+			{Name: "dns-search", Field: "DnsSearch", Type: "list", Default: "", Usage: "Set custom DNS search domains"},
+			{Name: "domainname", Field: "Domainname", Type: "string", Default: "", Usage: "Container NIS domain name"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "string", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables"},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables"},
+			{Name: "expose", Field: "Expose", Type: "list", Default: "", Usage: "Expose a port or a range of ports"},
+			{Name: "gpus", Field: "Gpus", Type: "gpu-request", Default: "", Usage: "GPU devices to add to the container ('all' to pass all GPUs)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "group-add", Field: "GroupAdd", Type: "list", Default: "", Usage: "Add additional groups to join"},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health"},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "0s", Usage: "Time between running the check (ms|s|m|h) (default 0s)"},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy"},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "0s", Usage: "Start period for the container to initialize before starting health-retries countdown (ms|s|m|h) (default 0s)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "0s", Usage: "Maximum time to allow one check to run (ms|s|m|h) (default 0s)"},
+			{Name: "help", Field: "Help", Type: "bool", Default: "false", Usage: "Print usage"},
+			{Name: "hostname", Shorthand: "h", Field: "Hostname", Type: "string", Default: "", Usage: "Container host name"},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Run an init inside the container that forwards signals and reaps processes", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "io-maxbandwidth", Field: "IoMaxbandwidth", Type: "bytes", Default: "0", Usage: "Maximum IO bandwidth limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "io-maxiops", Field: "IoMaxiops", Type: "uint64", Default: "0", Usage: "Maximum IOps limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "ip", Field: "Ip", Type: "string", Default: "", Usage: "IPv4 address (e.g., 172.30.100.104)"},
+			{Name: "ip6", Field: "Ip6", Type: "string", Default: "", Usage: "IPv6 address (e.g., 2001:db8::33)"},
+			{Name: "ipc", Field: "Ipc", Type: "string", Default: "", Usage: "IPC mode to use"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Set meta data on a container"},
+			{Name: "label-file", Field: "LabelFile", Type: "list", Default: "", Usage: "Read in a line delimited file of labels"},
+			{Name: "link", Field: "Link", Type: "list", Default: "", Usage: "Add link to another container"},
+			{Name: "link-local-ip", Field: "LinkLocalIp", Type: "list", Default: "", Usage: "Container IPv4/IPv6 link-local addresses"},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for the container"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Log driver options"},
+			{Name: "mac-address", Field: "MacAddress", Type: "string", Default: "", Usage: "Container MAC address (e.g., 92:d0:c6:0a:29:33)"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "memory-swappiness", Field: "MemorySwappiness", Type: "int64", Default: "-1", Usage: "Tune container memory swappiness (0 to 100)"},
+			{Name: "mount", Field: "Mount", Type: "mount", Default: "", Usage: "Attach a filesystem mount to the container"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Assign a name to the container"},
+			{Name: "net", Field: "Net", Type: "network", Default: "", Usage: "Connect a container to a network", Hidden: true},
+			{Name: "net-alias", Field: "NetAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container", Hidden: true},
+			{Name: "network", Field: "Network", Type: "network", Default: "", Usage: "Connect a container to a network"},
+			{Name: "network-alias", Field: "NetworkAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container"},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK"},
+			{Name: "oom-kill-disable", Field: "OomKillDisable", Type: "bool", Default: "false", Usage: "Disable OOM Killer"},
+			{Name: "oom-score-adj", Field: "OomScoreAdj", Type: "int", Default: "0", Usage: "Tune host's OOM preferences (-1000 to 1000)"},
+			{Name: "pid", Field: "Pid", Type: "string", Default: "", Usage: "PID namespace to use"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.32"}},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to this container"},
+			{Name: "publish", Shorthand: "p", Field: "Publish", Type: "list", Default: "", Usage: "Publish a container's port(s) to the host"},
+			{Name: "publish-all", Shorthand: "P", Field: "PublishAll", Type: "bool", Default: "false", Usage: "Publish all exposed ports to random ports"},
+			{Name: "pull", Field: "Pull", Type: "string", Default: "missing", Usage: "Pull image before creating (\"always\"|\"missing\"|\"never\")"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only"},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "no", Usage: "Restart policy to apply when a container exits"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "false", Usage: "Automatically remove the container when it exits"},
+			{Name: "runtime", Field: "Runtime", Type: "string", Default: "", Usage: "Runtime to use for this container"},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "list", Default: "", Usage: "Security Options"},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "SIGTERM", Usage: "Signal to stop a container"},
+			{Name: "stop-timeout", Field: "StopTimeout", Type: "int", Default: "0", Usage: "Timeout (in seconds) to stop a container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "storage-opt", Field: "StorageOpt", Type: "list", Default: "", Usage: "Storage driver options for the container"},
+			{Name: "sysctl", Field: "Sysctl", Type: "map", Default: "map[]", Usage: "Sysctl options"},
+			{Name: "tmpfs", Field: "Tmpfs", Type: "list", Default: "", Usage: "Mount a tmpfs directory"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "userns", Field: "Userns", Type: "string", Default: "", Usage: "User namespace to use"},
+			{Name: "uts", Field: "Uts", Type: "string", Default: "", Usage: "UTS namespace to use"},
+			{Name: "volume", Shorthand: "v", Field: "Volume", Type: "list", Default: "", Usage: "Bind mount a volume"},
+			{Name: "volume-driver", Field: "VolumeDriver", Type: "string", Default: "", Usage: "Optional volume driver for the container"},
+			{Name: "volumes-from", Field: "VolumesFrom", Type: "list", Default: "", Usage: "Mount volumes from the specified container(s)"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerCreateCmd(*opt.(*DockerCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerDiff",
+		Path:  []string{"docker", "diff"},
+		Use:   "diff CONTAINER",
+		Short: "Inspect changes to files or directories on a container's filesystem",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerDiffCmd(args)
+		},
+	},
+	{
+		Name:  "DockerEvents",
+		Path:  []string{"docker", "events"},
+		Use:   "events [OPTIONS]",
+		Short: "Get real time events from the server",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "since", Field: "Since", Type: "string", Default: "", Usage: "Show all events created since timestamp"},
+			{Name: "until", Field: "Until", Type: "string", Default: "", Usage: "Stream events until this timestamp"},
+		},
+		option: func() interface{} { return &DockerEventsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerEventsCmd(*opt.(*DockerEventsOption), args)
+		},
+	},
+	{
+		Name:  "DockerExec",
+		Path:  []string{"docker", "exec"},
+		Use:   "exec [OPTIONS] CONTAINER COMMAND [ARG...]",
+		Short: "Run a command in a running container",
+		Flags: []FlagInfo{
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Detached mode: run command in the background"},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to the command"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container", Annotations: Annotations{MinAPIVersion: "1.35"}},
+		},
+		option: func() interface{} { return &DockerExecOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerExecCmd(*opt.(*DockerExecOption), args)
+		},
+	},
+	{
+		Name:  "DockerExport",
+		Path:  []string{"docker", "export"},
+		Use:   "export [OPTIONS] CONTAINER",
+		Short: "Export a container's filesystem as a tar archive",
+		Flags: []FlagInfo{
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "string", Default: "", Usage: "Write to a file, instead of STDOUT"},
+		},
+		option: func() interface{} { return &DockerExportOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerExportCmd(*opt.(*DockerExportOption), args)
+		},
+	},
+	{
+		Name:  "DockerHistory",
+		Path:  []string{"docker", "history"},
+		Use:   "history [OPTIONS] IMAGE",
+		Short: "Show the history of an image",
+		Flags: []FlagInfo{
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "human", Shorthand: "H", Field: "Human", Type: "bool", Default: "true", Usage: "Print sizes and dates in human readable format"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only show image IDs"},
+		},
+		option: func() interface{} { return &DockerHistoryOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerHistoryCmd(*opt.(*DockerHistoryOption), args)
+		},
+	},
+	{
+		Name:  "DockerImage",
+		Path:  []string{"docker", "image"},
+		Use:   "image",
+		Short: "Manage images",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerImageCmd(args)
+		},
+	},
+	{
+		Name:  "DockerImageBuild",
+		Path:  []string{"docker", "image", "build"},
+		Use:   "build [OPTIONS] PATH | URL | -",
+		Short: "Build an image from a Dockerfile",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "build-arg", Field: "BuildArg", Type: "list", Default: "", Usage: "Set build-time variables"},
+			{Name: "cache-from", Field: "CacheFrom", Type: "stringSlice", Default: "[]", Usage: "Images to consider as cache sources"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "compress", Field: "Compress", Type: "bool", Default: "false", Usage: "Compress the build context using gzip", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) period", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit the CPU CFS (Completely Fair Scheduler) quota", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "file", Shorthand: "f", Field: "File", Type: "string", Default: "", Usage: "Name of the Dockerfile (Default is 'PATH/Dockerfile')"},
+			{Name: "force-rm", Field: "ForceRm", Type: "bool", Default: "false", Usage: "Always remove intermediate containers", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "iidfile", Field: "Iidfile", Type: "string", Default: "", Usage: "Write the image ID to the file"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "label", Field: "Label", Type: "list", Default: "", Usage: "Set metadata for an image"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "network", Field: "Network", Type: "string", Default: "default", Usage: "Set the networking mode for the RUN instructions during build", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "no-cache", Field: "NoCache", Type: "bool", Default: "false", Usage: "Do not use cache when building the image"},
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "stringArray", Default: "[]", Usage: "Output destination (format: type=local,dest=path)", Annotations: Annotations{MinAPIVersion: "1.40", BuildKit: true}},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+			{Name: "progress", Field: "Progress", Type: "string", Default: "auto", Usage: "Set type of progress output (auto, plain, tty). Use plain to show container output", Annotations: Annotations{BuildKit: true}},
+			{Name: "pull", Field: "Pull", Type: "bool", Default: "false", Usage: "Always attempt to pull a newer version of the image"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress the build output and print image ID on success"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "true", Usage: "Remove intermediate containers after a successful build", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "secret", Field: "Secret", Type: "stringArray", Default: "[]", Usage: "Secret file to expose to the build (only if BuildKit enabled): id=mysecret,src=/local/secret", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "stringSlice", Default: "[]", Usage: "Security options", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm", Annotations: Annotations{NoBuildKit: true}},
+			{Name: "squash", Field: "Squash", Type: "bool", Default: "false", Usage: "Squash newly built layers into a single new layer", Annotations: Annotations{MinAPIVersion: "1.25", Experimental: true}},
+			{Name: "ssh", Field: "Ssh", Type: "stringArray", Default: "[]", Usage: "SSH agent socket or keys to expose to the build (only if BuildKit enabled) (format: default|<id>[=<socket>|<key>[,<key>]])", Annotations: Annotations{MinAPIVersion: "1.39", BuildKit: true}},
+			{Name: "stream", Field: "Stream", Type: "bool", Default: "false", Usage: "Stream attaches to server to negotiate build context", Deprecated: "the experimental --stream flag was removed in docker 20.10", Hidden: true},
+			{Name: "tag", Shorthand: "t", Field: "Tag", Type: "list", Default: "", Usage: "Name and optionally a tag in the 'name:tag' format"},
+			{Name: "target", Field: "Target", Type: "string", Default: "", Usage: "Set the target build stage to build."},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options", Annotations: Annotations{NoBuildKit: true}},
+		},
+		option: func() interface{} { return &DockerImageBuildOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageBuildCmd(*opt.(*DockerImageBuildOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageHistory",
+		Path:  []string{"docker", "image", "history"},
+		Use:   "history [OPTIONS] IMAGE",
+		Short: "Show the history of an image",
+		Flags: []FlagInfo{
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "human", Shorthand: "H", Field: "Human", Type: "bool", Default: "true", Usage: "Print sizes and dates in human readable format"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only show image IDs"},
+		},
+		option: func() interface{} { return &DockerImageHistoryOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageHistoryCmd(*opt.(*DockerImageHistoryOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageImport",
+		Path:  []string{"docker", "image", "import"},
+		Use:   "import [OPTIONS] file|URL|- [REPOSITORY[:TAG]]",
+		Short: "Import the contents from a tarball to create a filesystem image",
+		Flags: []FlagInfo{
+			{Name: "change", Shorthand: "c", Field: "Change", Type: "list", Default: "", Usage: "Apply Dockerfile instruction to the created image"},
+			{Name: "message", Shorthand: "m", Field: "Message", Type: "string", Default: "", Usage: "Set commit message for imported image"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+		},
+		option: func() interface{} { return &DockerImageImportOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageImportCmd(*opt.(*DockerImageImportOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageInspect",
+		Path:  []string{"docker", "image", "inspect"},
+		Use:   "inspect [OPTIONS] IMAGE [IMAGE...]",
+		Short: "Display detailed information on one or more images",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerImageInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageInspectCmd(*opt.(*DockerImageInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageLoad",
+		Path:  []string{"docker", "image", "load"},
+		Use:   "load [OPTIONS]",
+		Short: "Load an image from a tar archive or STDIN",
+		Flags: []FlagInfo{
+			{Name: "input", Shorthand: "i", Field: "Input", Type: "string", Default: "", Usage: "Read from tar archive file, instead of STDIN"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress the load output"},
+		},
+		option: func() interface{} { return &DockerImageLoadOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageLoadCmd(*opt.(*DockerImageLoadOption), args)
+		},
+	},
+	{
+		Name:    "DockerImageLs",
+		Path:    []string{"docker", "image", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS] [REPOSITORY[:TAG]]",
+		Short:   "List images",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all images (default hides intermediate images)"},
+			{Name: "digests", Field: "Digests", Type: "bool", Default: "false", Usage: "Show digests"},
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only show image IDs"},
+		},
+		option: func() interface{} { return &DockerImageLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageLsCmd(*opt.(*DockerImageLsOption), args)
+		},
+	},
+	{
+		Name:        "DockerImagePrune",
+		Path:        []string{"docker", "image", "prune"},
+		Use:         "prune [OPTIONS]",
+		Short:       "Remove unused images",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Remove all unused images, not just dangling ones"},
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'until=<timestamp>')"},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+		},
+		option: func() interface{} { return &DockerImagePruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImagePruneCmd(*opt.(*DockerImagePruneOption), args)
+		},
+	},
+	{
+		Name:  "DockerImagePull",
+		Path:  []string{"docker", "image", "pull"},
+		Use:   "pull [OPTIONS] NAME[:TAG|@DIGEST]",
+		Short: "Pull an image or a repository from a registry",
+		Flags: []FlagInfo{
+			{Name: "all-tags", Shorthand: "a", Field: "AllTags", Type: "bool", Default: "false", Usage: "Download all tagged images in the repository"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress verbose output"},
+		},
+		option: func() interface{} { return &DockerImagePullOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImagePullCmd(*opt.(*DockerImagePullOption), args)
+		},
+	},
+	{
+		Name:  "DockerImagePush",
+		Path:  []string{"docker", "image", "push"},
+		Use:   "push [OPTIONS] NAME[:TAG]",
+		Short: "Push an image or a repository to a registry",
+		Flags: []FlagInfo{
+			{Name: "all-tags", Shorthand: "a", Field: "AllTags", Type: "bool", Default: "false", Usage: "Push all tagged images in the repository"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image signing"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress verbose output"},
+		},
+		option: func() interface{} { return &DockerImagePushOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImagePushCmd(*opt.(*DockerImagePushOption), args)
+		},
+	},
+	{
+		Name:    "DockerImageRm",
+		Path:    []string{"docker", "image", "rm"},
+		Aliases: []string{"rmi", "remove"},
+		Use:     "rm [OPTIONS] IMAGE [IMAGE...]",
+		Short:   "Remove one or more images",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force removal of the image"},
+			{Name: "no-prune", Field: "NoPrune", Type: "bool", Default: "false", Usage: "Do not delete untagged parents"},
+		},
+		option: func() interface{} { return &DockerImageRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageRmCmd(*opt.(*DockerImageRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageSave",
+		Path:  []string{"docker", "image", "save"},
+		Use:   "save [OPTIONS] IMAGE [IMAGE...]",
+		Short: "Save one or more images to a tar archive (streamed to STDOUT by default)",
+		Flags: []FlagInfo{
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "string", Default: "", Usage: "Write to a file, instead of STDOUT"},
+		},
+		option: func() interface{} { return &DockerImageSaveOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImageSaveCmd(*opt.(*DockerImageSaveOption), args)
+		},
+	},
+	{
+		Name:  "DockerImageTag",
+		Path:  []string{"docker", "image", "tag"},
+		Use:   "tag SOURCE_IMAGE[:TAG] TARGET_IMAGE[:TAG]",
+		Short: "Create a tag TARGET_IMAGE that refers to SOURCE_IMAGE",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerImageTagCmd(args)
+		},
+	},
+	{
+		Name:  "DockerImages",
+		Path:  []string{"docker", "images"},
+		Use:   "images [OPTIONS] [REPOSITORY[:TAG]]",
+		Short: "List images",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all images (default hides intermediate images)"},
+			{Name: "digests", Field: "Digests", Type: "bool", Default: "false", Usage: "Show digests"},
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only show image IDs"},
+		},
+		option: func() interface{} { return &DockerImagesOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImagesCmd(*opt.(*DockerImagesOption), args)
+		},
+	},
+	{
+		Name:  "DockerImport",
+		Path:  []string{"docker", "import"},
+		Use:   "import [OPTIONS] file|URL|- [REPOSITORY[:TAG]]",
+		Short: "Import the contents from a tarball to create a filesystem image",
+		Flags: []FlagInfo{
+			{Name: "change", Shorthand: "c", Field: "Change", Type: "list", Default: "", Usage: "Apply Dockerfile instruction to the created image"},
+			{Name: "message", Shorthand: "m", Field: "Message", Type: "string", Default: "", Usage: "Set commit message for imported image"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+		},
+		option: func() interface{} { return &DockerImportOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerImportCmd(*opt.(*DockerImportOption), args)
+		},
+	},
+	{
+		Name:  "DockerInfo",
+		Path:  []string{"docker", "info"},
+		Use:   "info [OPTIONS]",
+		Short: "Display system-wide information",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerInfoOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerInfoCmd(*opt.(*DockerInfoOption), args)
+		},
+	},
+	{
+		Name:  "DockerInspect",
+		Path:  []string{"docker", "inspect"},
+		Use:   "inspect [OPTIONS] NAME|ID [NAME|ID...]",
+		Short: "Return low-level information on Docker objects",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "size", Shorthand: "s", Field: "Size", Type: "bool", Default: "false", Usage: "Display total file sizes if the type is container"},
+			{Name: "type", Field: "Type", Type: "string", Default: "", Usage: "Return JSON for specified type"},
+		},
+		option: func() interface{} { return &DockerInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerInspectCmd(*opt.(*DockerInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerKill",
+		Path:  []string{"docker", "kill"},
+		Use:   "kill [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Kill one or more running containers",
+		Flags: []FlagInfo{
+			{Name: "signal", Shorthand: "s", Field: "Signal", Type: "string", Default: "KILL", Usage: "Signal to send to the container"},
+		},
+		option: func() interface{} { return &DockerKillOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerKillCmd(*opt.(*DockerKillOption), args)
+		},
+	},
+	{
+		Name:  "DockerLoad",
+		Path:  []string{"docker", "load"},
+		Use:   "load [OPTIONS]",
+		Short: "Load an image from a tar archive or STDIN",
+		Flags: []FlagInfo{
+			{Name: "input", Shorthand: "i", Field: "Input", Type: "string", Default: "", Usage: "Read from tar archive file, instead of STDIN"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress the load output"},
+		},
+		option: func() interface{} { return &DockerLoadOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerLoadCmd(*opt.(*DockerLoadOption), args)
+		},
+	},
+	{
+		Name:  "DockerLogin",
+		Path:  []string{"docker", "login"},
+		Use:   "login [OPTIONS] [SERVER]",
+		Short: "Log in to a Docker registry",
+		Flags: []FlagInfo{
+			{Name: "password", Shorthand: "p", Field: "Password", Type: "string", Default: "", Usage: "Password"},
+			{Name: "password-stdin", Field: "PasswordStdin", Type: "bool", Default: "false", Usage: "Take the password from stdin"},
+			{Name: "username", Shorthand: "u", Field: "Username", Type: "string", Default: "", Usage: "Username"},
+		},
+		option: func() interface{} { return &DockerLoginOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerLoginCmd(*opt.(*DockerLoginOption), args)
+		},
+	},
+	{
+		Name:  "DockerLogout",
+		Path:  []string{"docker", "logout"},
+		Use:   "logout [SERVER]",
+		Short: "Log out from a Docker registry",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerLogoutCmd(args)
+		},
+	},
+	{
+		Name:  "DockerLogs",
+		Path:  []string{"docker", "logs"},
+		Use:   "logs [OPTIONS] CONTAINER",
+		Short: "Fetch the logs of a container",
+		Flags: []FlagInfo{
+			{Name: "details", Field: "Details", Type: "bool", Default: "false", Usage: "Show extra details provided to logs"},
+			{Name: "follow", Shorthand: "f", Field: "Follow", Type: "bool", Default: "false", Usage: "Follow log output"},
+			{Name: "since", Field: "Since", Type: "string", Default: "", Usage: "Show logs since timestamp (e.g. 2013-01-02T13:23:37Z) or relative (e.g. 42m for 42 minutes)"},
+			{Name: "tail", Shorthand: "n", Field: "Tail", Type: "string", Default: "all", Usage: "Number of lines to show from the end of the logs"},
+			{Name: "timestamps", Shorthand: "t", Field: "Timestamps", Type: "bool", Default: "false", Usage: "Show timestamps"},
+			{Name: "until", Field: "Until", Type: "string", Default: "", Usage: "Show logs before a timestamp (e.g. 2013-01-02T13:23:37Z) or relative (e.g. 42m for 42 minutes)", Annotations: Annotations{MinAPIVersion: "1.35"}},
+		},
+		option: func() interface{} { return &DockerLogsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerLogsCmd(*opt.(*DockerLogsOption), args)
+		},
+	},
+	{
+		Name:        "DockerManifest",
+		Path:        []string{"docker", "manifest"},
+		Use:         "manifest COMMAND",
+		Short:       "Manage Docker image manifests and manifest lists",
+		Annotations: Annotations{ExperimentalCLI: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerManifestCmd(args)
+		},
+	},
+	{
+		Name:  "DockerManifestAnnotate",
+		Path:  []string{"docker", "manifest", "annotate"},
+		Use:   "annotate [OPTIONS] MANIFEST_LIST MANIFEST",
+		Short: "Add additional information to a local image manifest",
+		Flags: []FlagInfo{
+			{Name: "arch", Field: "Arch", Type: "string", Default: "", Usage: "Set architecture"},
+			{Name: "os", Field: "Os", Type: "string", Default: "", Usage: "Set operating system"},
+			{Name: "os-features", Field: "OsFeatures", Type: "stringSlice", Default: "[]", Usage: "Set operating system feature"},
+			{Name: "os-version", Field: "OsVersion", Type: "string", Default: "", Usage: "Set operating system version"},
+			{Name: "variant", Field: "Variant", Type: "string", Default: "", Usage: "Set architecture variant"},
+		},
+		option: func() interface{} { return &DockerManifestAnnotateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerManifestAnnotateCmd(*opt.(*DockerManifestAnnotateOption), args)
+		},
+	},
+	{
+		Name:  "DockerManifestCreate",
+		Path:  []string{"docker", "manifest", "create"},
+		Use:   "create MANIFEST_LIST MANIFEST [MANIFEST...]",
+		Short: "Create a local manifest list for annotating and pushing to a registry",
+		Flags: []FlagInfo{
+			{Name: "amend", Shorthand: "a", Field: "Amend", Type: "bool", Default: "false", Usage: "Amend an existing manifest list"},
+			{Name: "insecure", Field: "Insecure", Type: "bool", Default: "false", Usage: "Allow communication with an insecure registry"},
+		},
+		option: func() interface{} { return &DockerManifestCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerManifestCreateCmd(*opt.(*DockerManifestCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerManifestInspect",
+		Path:  []string{"docker", "manifest", "inspect"},
+		Use:   "inspect [OPTIONS] [MANIFEST_LIST] MANIFEST",
+		Short: "Display an image manifest, or manifest list",
+		Flags: []FlagInfo{
+			{Name: "insecure", Field: "Insecure", Type: "bool", Default: "false", Usage: "Allow communication with an insecure registry"},
+			{Name: "verbose", Shorthand: "v", Field: "Verbose", Type: "bool", Default: "false", Usage: "Output additional info including layers and platform"},
+		},
+		option: func() interface{} { return &DockerManifestInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerManifestInspectCmd(*opt.(*DockerManifestInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerManifestPush",
+		Path:  []string{"docker", "manifest", "push"},
+		Use:   "push [OPTIONS] MANIFEST_LIST",
+		Short: "Push a manifest list to a repository",
+		Flags: []FlagInfo{
+			{Name: "insecure", Field: "Insecure", Type: "bool", Default: "false", Usage: "Allow push to an insecure registry"},
+			{Name: "purge", Shorthand: "p", Field: "Purge", Type: "bool", Default: "false", Usage: "Remove the local manifest list after push"},
+		},
+		option: func() interface{} { return &DockerManifestPushOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerManifestPushCmd(*opt.(*DockerManifestPushOption), args)
+		},
+	},
+	{
+		Name:  "DockerManifestRm",
+		Path:  []string{"docker", "manifest", "rm"},
+		Use:   "rm MANIFEST_LIST [MANIFEST_LIST...]",
+		Short: "Delete one or more manifest lists from local storage",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerManifestRmCmd(args)
+		},
+	},
+	{
+		Name:        "DockerNetwork",
+		Path:        []string{"docker", "network"},
+		Use:         "network",
+		Short:       "Manage networks",
+		Annotations: Annotations{MinAPIVersion: "1.21"},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerNetworkCmd(args)
+		},
+	},
+	{
+		Name:  "DockerNetworkConnect",
+		Path:  []string{"docker", "network", "connect"},
+		Use:   "connect [OPTIONS] NETWORK CONTAINER",
+		Short: "Connect a container to a network",
+		Flags: []FlagInfo{
+			{Name: "alias", Field: "Alias", Type: "stringSlice", Default: "[]", Usage: "Add network-scoped alias for the container"},
+			{Name: "driver-opt", Field: "DriverOpt", Type: "stringSlice", Default: "[]", Usage: "driver options for the network"},
+			{Name: "ip", Field: "Ip", Type: "string", Default: "", Usage: "IPv4 address (e.g., 172.30.100.104)"},
+			{Name: "ip6", Field: "Ip6", Type: "string", Default: "", Usage: "IPv6 address (e.g., 2001:db8::33)"},
+			{Name: "link", Field: "Link", Type: "list", Default: "", Usage: "Add link to another container"},
+			{Name: "link-local-ip", Field: "LinkLocalIp", Type: "stringSlice", Default: "[]", Usage: "Add a link-local address for the container"},
+		},
+		option: func() interface{} { return &DockerNetworkConnectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkConnectCmd(*opt.(*DockerNetworkConnectOption), args)
+		},
+	},
+	{
+		Name:  "DockerNetworkCreate",
+		Path:  []string{"docker", "network", "create"},
+		Use:   "create [OPTIONS] NETWORK",
+		Short: "Create a network",
+		Flags: []FlagInfo{
+			{Name: "attachable", Field: "Attachable", Type: "bool", Default: "false", Usage: "Enable manual container attachment", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "aux-address", Field: "AuxAddress", Type: "map", Default: "map[]", Usage: "Auxiliary IPv4 or IPv6 addresses used by Network driver"},
+			{Name: "config-from", Field: "ConfigFrom", Type: "string", Default: "", Usage: "The network from which to copy the configuration", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "config-only", Field: "ConfigOnly", Type: "bool", Default: "false", Usage: "Create a configuration only network", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "driver", Shorthand: "d", Field: "Driver", Type: "string", Default: "bridge", Usage: "Driver to manage the Network"},
+			{Name: "gateway", Field: "Gateway", Type: "stringSlice", Default: "[]", Usage: "IPv4 or IPv6 Gateway for the master subnet"},
+			{Name: "ingress", Field: "Ingress", Type: "bool", Default: "false", Usage: "Create swarm routing-mesh network", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "internal", Field: "Internal", Type: "bool", Default: "false", Usage: "Restrict external access to the network"},
+			{Name: "ip-range", Field: "IpRange", Type: "stringSlice", Default: "[]", Usage: "Allocate container ip from a sub-range"},
+			{Name: "ipam-driver", Field: "IpamDriver", Type: "string", Default: "default", Usage: "IP Address Management Driver"},
+			{Name: "ipam-opt", Field: "IpamOpt", Type: "map", Default: "map[]", Usage: "Set IPAM driver specific options"},
+			{Name: "ipv6", Field: "Ipv6", Type: "bool", Default: "false", Usage: "Enable IPv6 networking"},
+			{Name: "label", Field: "Label", Type: "list", Default: "", Usage: "Set metadata on a network"},
+			{Name: "opt", Shorthand: "o", Field: "Opt", Type: "map", Default: "map[]", Usage: "Set driver specific options"},
+			{Name: "scope", Field: "Scope", Type: "string", Default: "", Usage: "Control the network's scope", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "subnet", Field: "Subnet", Type: "stringSlice", Default: "[]", Usage: "Subnet in CIDR format that represents a network segment"},
+		},
+		option: func() interface{} { return &DockerNetworkCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkCreateCmd(*opt.(*DockerNetworkCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerNetworkDisconnect",
+		Path:  []string{"docker", "network", "disconnect"},
+		Use:   "disconnect [OPTIONS] NETWORK CONTAINER",
+		Short: "Disconnect a container from a network",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the container to disconnect from a network"},
+		},
+		option: func() interface{} { return &DockerNetworkDisconnectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkDisconnectCmd(*opt.(*DockerNetworkDisconnectOption), args)
+		},
+	},
+	{
+		Name:  "DockerNetworkInspect",
+		Path:  []string{"docker", "network", "inspect"},
+		Use:   "inspect [OPTIONS] NETWORK [NETWORK...]",
+		Short: "Display detailed information on one or more networks",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "verbose", Shorthand: "v", Field: "Verbose", Type: "bool", Default: "false", Usage: "Verbose output for diagnostics"},
+		},
+		option: func() interface{} { return &DockerNetworkInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkInspectCmd(*opt.(*DockerNetworkInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerNetworkLs",
+		Path:    []string{"docker", "network", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List networks",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'driver=bridge')"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print networks using a Go template"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate the output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display network IDs"},
+		},
+		option: func() interface{} { return &DockerNetworkLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkLsCmd(*opt.(*DockerNetworkLsOption), args)
+		},
+	},
+	{
+		Name:        "DockerNetworkPrune",
+		Path:        []string{"docker", "network", "prune"},
+		Use:         "prune [OPTIONS]",
+		Short:       "Remove all unused networks",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'until=<timestamp>')"},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+		},
+		option: func() interface{} { return &DockerNetworkPruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNetworkPruneCmd(*opt.(*DockerNetworkPruneOption), args)
+		},
+	},
+	{
+		Name:    "DockerNetworkRm",
+		Path:    []string{"docker", "network", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm NETWORK [NETWORK...]",
+		Short:   "Remove one or more networks",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerNetworkRmCmd(args)
+		},
+	},
+	{
+		Name:        "DockerNode",
+		Path:        []string{"docker", "node"},
+		Use:         "node",
+		Short:       "Manage Swarm nodes",
+		Annotations: Annotations{MinAPIVersion: "1.24", Swarm: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerNodeCmd(args)
+		},
+	},
+	{
+		Name:  "DockerNodeDemote",
+		Path:  []string{"docker", "node", "demote"},
+		Use:   "demote NODE [NODE...]",
+		Short: "Demote one or more nodes from manager in the swarm",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerNodeDemoteCmd(args)
+		},
+	},
+	{
+		Name:  "DockerNodeInspect",
+		Path:  []string{"docker", "node", "inspect"},
+		Use:   "inspect [OPTIONS] self|NODE [NODE...]",
+		Short: "Display detailed information on one or more nodes",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "pretty", Field: "Pretty", Type: "bool", Default: "false", Usage: "Print the information in a human friendly format"},
+		},
+		option: func() interface{} { return &DockerNodeInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNodeInspectCmd(*opt.(*DockerNodeInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerNodeLs",
+		Path:    []string{"docker", "node", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List nodes in the swarm",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print nodes using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display IDs"},
+		},
+		option: func() interface{} { return &DockerNodeLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNodeLsCmd(*opt.(*DockerNodeLsOption), args)
+		},
+	},
+	{
+		Name:  "DockerNodePromote",
+		Path:  []string{"docker", "node", "promote"},
+		Use:   "promote NODE [NODE...]",
+		Short: "Promote one or more nodes to manager in the swarm",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerNodePromoteCmd(args)
+		},
+	},
+	{
+		Name:  "DockerNodePs",
+		Path:  []string{"docker", "node", "ps"},
+		Use:   "ps [OPTIONS] [NODE...]",
+		Short: "List tasks running on one or more nodes, defaults to current node",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print tasks using a Go template"},
+			{Name: "no-resolve", Field: "NoResolve", Type: "bool", Default: "false", Usage: "Do not map IDs to Names"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display task IDs"},
+		},
+		option: func() interface{} { return &DockerNodePsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNodePsCmd(*opt.(*DockerNodePsOption), args)
+		},
+	},
+	{
+		Name:    "DockerNodeRm",
+		Path:    []string{"docker", "node", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm [OPTIONS] NODE [NODE...]",
+		Short:   "Remove one or more nodes from the swarm",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force remove a node from the swarm"},
+		},
+		option: func() interface{} { return &DockerNodeRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNodeRmCmd(*opt.(*DockerNodeRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerNodeUpdate",
+		Path:  []string{"docker", "node", "update"},
+		Use:   "update [OPTIONS] NODE",
+		Short: "Update a node",
+		Flags: []FlagInfo{
+			{Name: "availability", Field: "Availability", Type: "string", Default: "", Usage: "Availability of the node (\"active\"|\"pause\"|\"drain\")"},
+			{Name: "label-add", Field: "LabelAdd", Type: "list", Default: "", Usage: "Add or update a node label (key=value)"},
+			{Name: "label-rm", Field: "LabelRm", Type: "list", Default: "", Usage: "Remove a node label if exists"},
+			{Name: "role", Field: "Role", Type: "string", Default: "", Usage: "Role of the node (\"worker\"|\"manager\")"},
+		},
+		option: func() interface{} { return &DockerNodeUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerNodeUpdateCmd(*opt.(*DockerNodeUpdateOption), args)
+		},
+	},
+	{
+		Name:  "DockerPause",
+		Path:  []string{"docker", "pause"},
+		Use:   "pause CONTAINER [CONTAINER...]",
+		Short: "Pause all processes within one or more containers",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerPauseCmd(args)
+		},
+	},
+	{
+		Name:        "DockerPlugin",
+		Path:        []string{"docker", "plugin"},
+		Use:         "plugin",
+		Short:       "Manage plugins",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerPluginCmd(args)
+		},
+	},
+	{
+		Name:  "DockerPluginCreate",
+		Path:  []string{"docker", "plugin", "create"},
+		Use:   "create [OPTIONS] PLUGIN PLUGIN-DATA-DIR",
+		Short: "Create a plugin from a rootfs and configuration. Plugin data directory must contain config.json and rootfs directory.",
+		Flags: []FlagInfo{
+			{Name: "compress", Field: "Compress", Type: "bool", Default: "false", Usage: "Compress the context using gzip"},
+		},
+		option: func() interface{} { return &DockerPluginCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginCreateCmd(*opt.(*DockerPluginCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginDisable",
+		Path:  []string{"docker", "plugin", "disable"},
+		Use:   "disable [OPTIONS] PLUGIN",
+		Short: "Disable a plugin",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the disable of an active plugin"},
+		},
+		option: func() interface{} { return &DockerPluginDisableOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginDisableCmd(*opt.(*DockerPluginDisableOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginEnable",
+		Path:  []string{"docker", "plugin", "enable"},
+		Use:   "enable [OPTIONS] PLUGIN",
+		Short: "Enable a plugin",
+		Flags: []FlagInfo{
+			{Name: "timeout", Field: "Timeout", Type: "int", Default: "30", Usage: "HTTP client timeout (in seconds)"},
+		},
+		option: func() interface{} { return &DockerPluginEnableOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginEnableCmd(*opt.(*DockerPluginEnableOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginInspect",
+		Path:  []string{"docker", "plugin", "inspect"},
+		Use:   "inspect [OPTIONS] PLUGIN [PLUGIN...]",
+		Short: "Display detailed information on one or more plugins",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerPluginInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginInspectCmd(*opt.(*DockerPluginInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginInstall",
+		Path:  []string{"docker", "plugin", "install"},
+		Use:   "install [OPTIONS] PLUGIN [KEY=VALUE...]",
+		Short: "Install a plugin",
+		Flags: []FlagInfo{
+			{Name: "alias", Field: "Alias", Type: "string", Default: "", Usage: "Local name for plugin"},
+			{Name: "disable", Field: "Disable", Type: "bool", Default: "false", Usage: "Do not enable the plugin on install"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "grant-all-permissions", Field: "GrantAllPermissions", Type: "bool", Default: "false", Usage: "Grant all permissions necessary to run the plugin"},
+		},
+		option: func() interface{} { return &DockerPluginInstallOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginInstallCmd(*opt.(*DockerPluginInstallOption), args)
+		},
+	},
+	{
+		Name:    "DockerPluginLs",
+		Path:    []string{"docker", "plugin", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List plugins",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'enabled=true')"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print plugins using a Go template"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display plugin IDs"},
+		},
+		option: func() interface{} { return &DockerPluginLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginLsCmd(*opt.(*DockerPluginLsOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginPush",
+		Path:  []string{"docker", "plugin", "push"},
+		Use:   "push [OPTIONS] PLUGIN[:TAG]",
+		Short: "Push a plugin to a registry",
+		Flags: []FlagInfo{
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image signing"},
+		},
+		option: func() interface{} { return &DockerPluginPushOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginPushCmd(*opt.(*DockerPluginPushOption), args)
+		},
+	},
+	{
+		Name:    "DockerPluginRm",
+		Path:    []string{"docker", "plugin", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm [OPTIONS] PLUGIN [PLUGIN...]",
+		Short:   "Remove one or more plugins",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the removal of an active plugin"},
+		},
+		option: func() interface{} { return &DockerPluginRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginRmCmd(*opt.(*DockerPluginRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerPluginSet",
+		Path:  []string{"docker", "plugin", "set"},
+		Use:   "set PLUGIN KEY=VALUE [KEY=VALUE...]",
+		Short: "Change settings for a plugin",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerPluginSetCmd(args)
+		},
+	},
+	{
+		Name:        "DockerPluginUpgrade",
+		Path:        []string{"docker", "plugin", "upgrade"},
+		Use:         "upgrade [OPTIONS] PLUGIN [REMOTE]",
+		Short:       "Upgrade an existing plugin",
+		Annotations: Annotations{MinAPIVersion: "1.26"},
+		Flags: []FlagInfo{
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "grant-all-permissions", Field: "GrantAllPermissions", Type: "bool", Default: "false", Usage: "Grant all permissions necessary to run the plugin"},
+			{Name: "skip-remote-check", Field: "SkipRemoteCheck", Type: "bool", Default: "false", Usage: "Do not check if specified remote plugin matches existing plugin image"},
+		},
+		option: func() interface{} { return &DockerPluginUpgradeOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPluginUpgradeCmd(*opt.(*DockerPluginUpgradeOption), args)
+		},
+	},
+	{
+		Name:  "DockerPort",
+		Path:  []string{"docker", "port"},
+		Use:   "port CONTAINER [PRIVATE_PORT[/PROTO]]",
+		Short: "List port mappings or a specific mapping for the container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerPortCmd(args)
+		},
+	},
+	{
+		Name:  "DockerPs",
+		Path:  []string{"docker", "ps"},
+		Use:   "ps [OPTIONS]",
+		Short: "List containers",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all containers (default shows just running)"},
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print containers using a Go template"},
+			{Name: "last", Shorthand: "n", Field: "Last", Type: "int", Default: "-1", Usage: "Show n last created containers (includes all states)"},
+			{Name: "latest", Shorthand: "l", Field: "Latest", Type: "bool", Default: "false", Usage: "Show the latest created container (includes all states)"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display container IDs"},
+			{Name: "size", Shorthand: "s", Field: "Size", Type: "bool", Default: "false", Usage: "Display total file sizes"},
+		},
+		option: func() interface{} { return &DockerPsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPsCmd(*opt.(*DockerPsOption), args)
+		},
+	},
+	{
+		Name:  "DockerPull",
+		Path:  []string{"docker", "pull"},
+		Use:   "pull [OPTIONS] NAME[:TAG|@DIGEST]",
+		Short: "Pull an image or a repository from a registry",
+		Flags: []FlagInfo{
+			{Name: "all-tags", Shorthand: "a", Field: "AllTags", Type: "bool", Default: "false", Usage: "Download all tagged images in the repository"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.38", BuildKit: true}},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress verbose output"},
+		},
+		option: func() interface{} { return &DockerPullOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPullCmd(*opt.(*DockerPullOption), args)
+		},
+	},
+	{
+		Name:  "DockerPush",
+		Path:  []string{"docker", "push"},
+		Use:   "push [OPTIONS] NAME[:TAG]",
+		Short: "Push an image or a repository to a registry",
+		Flags: []FlagInfo{
+			{Name: "all-tags", Shorthand: "a", Field: "AllTags", Type: "bool", Default: "false", Usage: "Push all tagged images in the repository"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image signing"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress verbose output"},
+		},
+		option: func() interface{} { return &DockerPushOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerPushCmd(*opt.(*DockerPushOption), args)
+		},
+	},
+	{
+		Name:  "DockerRename",
+		Path:  []string{"docker", "rename"},
+		Use:   "rename CONTAINER NEW_NAME",
+		Short: "Rename a container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerRenameCmd(args)
+		},
+	},
+	{
+		Name:  "DockerRestart",
+		Path:  []string{"docker", "restart"},
+		Use:   "restart [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Restart one or more containers",
+		Flags: []FlagInfo{
+			{Name: "time", Shorthand: "t", Field: "Time", Type: "int", Default: "10", Usage: "Seconds to wait for stop before killing the container"},
+		},
+		option: func() interface{} { return &DockerRestartOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerRestartCmd(*opt.(*DockerRestartOption), args)
+		},
+	},
+	{
+		Name:  "DockerRm",
+		Path:  []string{"docker", "rm"},
+		Use:   "rm [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Remove one or more containers",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the removal of a running container (uses SIGKILL)"},
+			{Name: "link", Shorthand: "l", Field: "Link", Type: "bool", Default: "false", Usage: "Remove the specified link"},
+			{Name: "volumes", Shorthand: "v", Field: "Volumes", Type: "bool", Default: "false", Usage: "Remove anonymous volumes associated with the container"},
+		},
+		option: func() interface{} { return &DockerRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerRmCmd(*opt.(*DockerRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerRmi",
+		Path:  []string{"docker", "rmi"},
+		Use:   "rmi [OPTIONS] IMAGE [IMAGE...]",
+		Short: "Remove one or more images",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force removal of the image"},
+			{Name: "no-prune", Field: "NoPrune", Type: "bool", Default: "false", Usage: "Do not delete untagged parents"},
+		},
+		option: func() interface{} { return &DockerRmiOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerRmiCmd(*opt.(*DockerRmiOption), args)
+		},
+	},
+	{
+		Name:  "DockerRun",
+		Path:  []string{"docker", "run"},
+		Use:   "run [OPTIONS] IMAGE [COMMAND] [ARG...]",
+		Short: "Run a command in a new container",
+		Flags: []FlagInfo{
+			{Name: "add-host", Field: "AddHost", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)"},
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "list", Default: "", Usage: "Attach to STDIN, STDOUT or STDERR"},
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "blkio-weight-device", Field: "BlkioWeightDevice", Type: "list", Default: "[]", Usage: "Block IO weight (relative device weight)"},
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities"},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities"},
+			{Name: "cgroup-parent", Field: "CgroupParent", Type: "string", Default: "", Usage: "Optional parent cgroup for the container"},
+			{Name: "cgroupns", Field: "Cgroupns", Type: "string", Default: "", Usage: "\tCgroup namespace to use (host|private)\n'host':    Run the container in the Docker host's cgroup namespace\n'private': Run the container in its own private cgroup namespace\n'':        Use the cgroup namespace as configured by the\n           default-cgroupns-mode option on the daemon (default)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cidfile", Field: "Cidfile", Type: "string", Default: "", Usage: "Write the container ID to the file"},
+			{Name: "cpu-count", Field: "CpuCount", Type: "int64", Default: "0", Usage: "CPU count (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-percent", Field: "CpuPercent", Type: "int64", Default: "0", Usage: "CPU percent (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Run container in background and print container ID"},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "device", Field: "Device", Type: "list", Default: "", Usage: "Add a host device to the container"},
+			{Name: "device-cgroup-rule", Field: "DeviceCgroupRule", Type: "list", Default: "", Usage: "Add a rule to the cgroup allowed devices list"},
+			{Name: "device-read-bps", Field: "DeviceReadBps", Type: "list", Default: "[]", Usage: "Limit read rate (bytes per second) from a device"},
+			{Name: "device-read-iops", Field: "DeviceReadIops", Type: "list", Default: "[]", Usage: "Limit read rate (IO per second) from a device"},
+			{Name: "device-write-bps", Field: "DeviceWriteBps", Type: "list", Default: "[]", Usage: "Limit write rate (bytes per second) to a device"},
+			{Name: "device-write-iops", Field: "DeviceWriteIops", Type: "list", Default: "[]", Usage: "Limit write rate (IO per second) to a device"},
+			{Name: "disable-content-trust", Field: "DisableContentTrust", Type: "bool", Default: "true", Usage: "Skip image verification"},
+			{Name: "dns", Field: "Dns", Type: "list", Default: "", Usage: "Set custom DNS servers"},
+			{Name: "dns-opt", Field: "DnsOpt", Type: "list", Default: "", Usage: "Set DNS options", Hidden: true},
+			{Name: "dns-option", Field: "DnsOption", Type: "list", Default: "", Usage: "Set DNS options"},
+			{Name: "dns-search", Field: "DnsSearch", Type: "list", Default: "", Usage: "Set custom DNS search domains"},
+			{Name: "domainname", Field: "Domainname", Type: "string", Default: "", Usage: "Container NIS domain name"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "string", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables"},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables"},
+			{Name: "expose", Field: "Expose", Type: "list", Default: "", Usage: "Expose a port or a range of ports"},
+			{Name: "gpus", Field: "Gpus", Type: "gpu-request", Default: "", Usage: "GPU devices to add to the container ('all' to pass all GPUs)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "group-add", Field: "GroupAdd", Type: "list", Default: "", Usage: "Add additional groups to join"},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health"},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "0s", Usage: "Time between running the check (ms|s|m|h) (default 0s)"},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy"},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "0s", Usage: "Start period for the container to initialize before starting health-retries countdown (ms|s|m|h) (default 0s)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "0s", Usage: "Maximum time to allow one check to run (ms|s|m|h) (default 0s)"},
+			{Name: "help", Field: "Help", Type: "bool", Default: "false", Usage: "Print usage"},
+			{Name: "hostname", Shorthand: "h", Field: "Hostname", Type: "string", Default: "", Usage: "Container host name"},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Run an init inside the container that forwards signals and reaps processes", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Keep STDIN open even if not attached"},
+			{Name: "io-maxbandwidth", Field: "IoMaxbandwidth", Type: "bytes", Default: "0", Usage: "Maximum IO bandwidth limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "io-maxiops", Field: "IoMaxiops", Type: "uint64", Default: "0", Usage: "Maximum IOps limit for the system drive (Windows only)", Annotations: Annotations{OSType: "windows"}},
+			{Name: "ip", Field: "Ip", Type: "string", Default: "", Usage: "IPv4 address (e.g., 172.30.100.104)"},
+			{Name: "ip6", Field: "Ip6", Type: "string", Default: "", Usage: "IPv6 address (e.g., 2001:db8::33)"},
+			{Name: "ipc", Field: "Ipc", Type: "string", Default: "", Usage: "IPC mode to use"},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Container isolation technology"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Set meta data on a container"},
+			{Name: "label-file", Field: "LabelFile", Type: "list", Default: "", Usage: "Read in a line delimited file of labels"},
+			{Name: "link", Field: "Link", Type: "list", Default: "", Usage: "Add link to another container"},
+			{Name: "link-local-ip", Field: "LinkLocalIp", Type: "list", Default: "", Usage: "Container IPv4/IPv6 link-local addresses"},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for the container"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Log driver options"},
+			{Name: "mac-address", Field: "MacAddress", Type: "string", Default: "", Usage: "Container MAC address (e.g., 92:d0:c6:0a:29:33)"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "memory-swappiness", Field: "MemorySwappiness", Type: "int64", Default: "-1", Usage: "Tune container memory swappiness (0 to 100)"},
+			{Name: "mount", Field: "Mount", Type: "mount", Default: "", Usage: "Attach a filesystem mount to the container"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Assign a name to the container"},
+			{Name: "net", Field: "Net", Type: "network", Default: "", Usage: "Connect a container to a network", Hidden: true},
+			{Name: "net-alias", Field: "NetAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container", Hidden: true},
+			{Name: "network", Field: "Network", Type: "network", Default: "", Usage: "Connect a container to a network"},
+			{Name: "network-alias", Field: "NetworkAlias", Type: "list", Default: "", Usage: "Add network-scoped alias for the container"},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK"},
+			{Name: "oom-kill-disable", Field: "OomKillDisable", Type: "bool", Default: "false", Usage: "Disable OOM Killer"},
+			{Name: "oom-score-adj", Field: "OomScoreAdj", Type: "int", Default: "0", Usage: "Tune host's OOM preferences (-1000 to 1000)"},
+			{Name: "pid", Field: "Pid", Type: "string", Default: "", Usage: "PID namespace to use"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)"},
+			{Name: "platform", Field: "Platform", Type: "string", Default: "", Usage: "Set platform if server is multi-platform capable", Annotations: Annotations{MinAPIVersion: "1.32"}},
+			{Name: "privileged", Field: "Privileged", Type: "bool", Default: "false", Usage: "Give extended privileges to this container"},
+			{Name: "publish", Shorthand: "p", Field: "Publish", Type: "list", Default: "", Usage: "Publish a container's port(s) to the host"},
+			{Name: "publish-all", Shorthand: "P", Field: "PublishAll", Type: "bool", Default: "false", Usage: "Publish all exposed ports to random ports"},
+			{Name: "pull", Field: "Pull", Type: "string", Default: "missing", Usage: "Pull image before running (\"always\"|\"missing\"|\"never\")"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only"},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "no", Usage: "Restart policy to apply when a container exits"},
+			{Name: "rm", Field: "Rm", Type: "bool", Default: "false", Usage: "Automatically remove the container when it exits"},
+			{Name: "runtime", Field: "Runtime", Type: "string", Default: "", Usage: "Runtime to use for this container"},
+			{Name: "security-opt", Field: "SecurityOpt", Type: "list", Default: "", Usage: "Security Options"},
+			{Name: "shm-size", Field: "ShmSize", Type: "bytes", Default: "0", Usage: "Size of /dev/shm"},
+			{Name: "sig-proxy", Field: "SigProxy", Type: "bool", Default: "true", Usage: "Proxy received signals to the process"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "SIGTERM", Usage: "Signal to stop a container"},
+			{Name: "stop-timeout", Field: "StopTimeout", Type: "int", Default: "0", Usage: "Timeout (in seconds) to stop a container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "storage-opt", Field: "StorageOpt", Type: "list", Default: "", Usage: "Storage driver options for the container"},
+			{Name: "sysctl", Field: "Sysctl", Type: "map", Default: "map[]", Usage: "Sysctl options"},
+			{Name: "tmpfs", Field: "Tmpfs", Type: "list", Default: "", Usage: "Mount a tmpfs directory"},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY"},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "userns", Field: "Userns", Type: "string", Default: "", Usage: "User namespace to use"},
+			{Name: "uts", Field: "Uts", Type: "string", Default: "", Usage: "UTS namespace to use"},
+			{Name: "volume", Shorthand: "v", Field: "Volume", Type: "list", Default: "", Usage: "Bind mount a volume"},
+			{Name: "volume-driver", Field: "VolumeDriver", Type: "string", Default: "", Usage: "Optional volume driver for the container"},
+			{Name: "volumes-from", Field: "VolumesFrom", Type: "list", Default: "", Usage: "Mount volumes from the specified container(s)"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerRunOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerRunCmd(*opt.(*DockerRunOption), args)
+		},
+	},
+	{
+		Name:  "DockerSave",
+		Path:  []string{"docker", "save"},
+		Use:   "save [OPTIONS] IMAGE [IMAGE...]",
+		Short: "Save one or more images to a tar archive (streamed to STDOUT by default)",
+		Flags: []FlagInfo{
+			{Name: "output", Shorthand: "o", Field: "Output", Type: "string", Default: "", Usage: "Write to a file, instead of STDOUT"},
+		},
+		option: func() interface{} { return &DockerSaveOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSaveCmd(*opt.(*DockerSaveOption), args)
+		},
+	},
+	{
+		Name:  "DockerSearch",
+		Path:  []string{"docker", "search"},
+		Use:   "search [OPTIONS] TERM",
+		Short: "Search the Docker Hub for images",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print search using a Go template"},
+			{Name: "limit", Field: "Limit", Type: "int", Default: "25", Usage: "Max number of search results"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Don't truncate output"},
+		},
+		option: func() interface{} { return &DockerSearchOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSearchCmd(*opt.(*DockerSearchOption), args)
+		},
+	},
+	{
+		Name:        "DockerSecret",
+		Path:        []string{"docker", "secret"},
+		Use:         "secret",
+		Short:       "Manage Docker secrets",
+		Annotations: Annotations{MinAPIVersion: "1.25", Swarm: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSecretCmd(args)
+		},
+	},
+	{
+		Name:  "DockerSecretCreate",
+		Path:  []string{"docker", "secret", "create"},
+		Use:   "create [OPTIONS] SECRET [file|-]",
+		Short: "Create a secret from a file or STDIN as content",
+		Flags: []FlagInfo{
+			{Name: "driver", Shorthand: "d", Field: "Driver", Type: "string", Default: "", Usage: "Secret driver", Annotations: Annotations{MinAPIVersion: "1.31"}},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Secret labels"},
+			{Name: "template-driver", Field: "TemplateDriver", Type: "string", Default: "", Usage: "Template driver", Annotations: Annotations{MinAPIVersion: "1.37"}},
+		},
+		option: func() interface{} { return &DockerSecretCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSecretCreateCmd(*opt.(*DockerSecretCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerSecretInspect",
+		Path:  []string{"docker", "secret", "inspect"},
+		Use:   "inspect [OPTIONS] SECRET [SECRET...]",
+		Short: "Display detailed information on one or more secrets",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "pretty", Field: "Pretty", Type: "bool", Default: "false", Usage: "Print the information in a human friendly format"},
+		},
+		option: func() interface{} { return &DockerSecretInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSecretInspectCmd(*opt.(*DockerSecretInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerSecretLs",
+		Path:    []string{"docker", "secret", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List secrets",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print secrets using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display IDs"},
+		},
+		option: func() interface{} { return &DockerSecretLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSecretLsCmd(*opt.(*DockerSecretLsOption), args)
+		},
+	},
+	{
+		Name:    "DockerSecretRm",
+		Path:    []string{"docker", "secret", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm SECRET [SECRET...]",
+		Short:   "Remove one or more secrets",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSecretRmCmd(args)
+		},
+	},
+	{
+		Name:        "DockerService",
+		Path:        []string{"docker", "service"},
+		Use:         "service",
+		Short:       "Manage services",
+		Annotations: Annotations{MinAPIVersion: "1.24", Swarm: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerServiceCmd(args)
+		},
+	},
+	{
+		Name:  "DockerServiceCreate",
+		Path:  []string{"docker", "service", "create"},
+		Use:   "create [OPTIONS] IMAGE [COMMAND] [ARG...]",
+		Short: "Create a new service",
+		Flags: []FlagInfo{
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "config", Field: "Config", Type: "config", Default: "", Usage: "Specify configurations to expose to the service", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "constraint", Field: "Constraint", Type: "list", Default: "", Usage: "Placement constraints"},
+			{Name: "container-label", Field: "ContainerLabel", Type: "list", Default: "", Usage: "Container labels"},
+			{Name: "credential-spec", Field: "CredentialSpec", Type: "credential-spec", Default: "", Usage: "Credential spec for managed service account (Windows only)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Exit immediately instead of waiting for the service to converge", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "dns", Field: "Dns", Type: "list", Default: "", Usage: "Set custom DNS servers", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-option", Field: "DnsOption", Type: "list", Default: "", Usage: "Set DNS options", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-search", Field: "DnsSearch", Type: "list", Default: "", Usage: "Set custom DNS search domains", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "endpoint-mode", Field: "EndpointMode", Type: "string", Default: "vip", Usage: "Endpoint mode (vip or dnsrr)"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "command", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env", Shorthand: "e", Field: "Env", Type: "list", Default: "", Usage: "Set environment variables"},
+			{Name: "env-file", Field: "EnvFile", Type: "list", Default: "", Usage: "Read in a file of environment variables"},
+			{Name: "generic-resource", Field: "GenericResource", Type: "list", Default: "", Usage: "User defined resources"},
+			{Name: "group", Field: "Group", Type: "list", Default: "", Usage: "Set one or more supplementary user groups for the container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "", Usage: "Time between running the check (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "", Usage: "Start period for the container to initialize before counting retries towards unstable (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "", Usage: "Maximum time to allow one check to run (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "host", Field: "Host", Type: "list", Default: "", Usage: "Set one or more custom host-to-IP mappings (host:ip)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "hostname", Field: "Hostname", Type: "string", Default: "", Usage: "Container hostname", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Use an init inside each service container to forward signals and reap processes", Annotations: Annotations{MinAPIVersion: "1.37"}},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Service container isolation mode", Annotations: Annotations{MinAPIVersion: "1.35"}},
+			{Name: "label", Shorthand: "l", Field: "Label", Type: "list", Default: "", Usage: "Service labels"},
+			{Name: "limit-cpu", Field: "LimitCpu", Type: "decimal", Default: "", Usage: "Limit CPUs"},
+			{Name: "limit-memory", Field: "LimitMemory", Type: "bytes", Default: "0", Usage: "Limit Memory"},
+			{Name: "limit-pids", Field: "LimitPids", Type: "int64", Default: "0", Usage: "Limit maximum number of processes (default 0 = unlimited)", Annotations: Annotations{MinAPIVersion: "1.41", Swarm: true}},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for service"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Logging driver options"},
+			{Name: "max-concurrent", Field: "MaxConcurrent", Type: "uint", Default: "", Usage: "Number of job tasks to run concurrently (default equal to --replicas)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "mode", Field: "Mode", Type: "string", Default: "replicated", Usage: "Service mode (replicated, global, replicated-job, or global-job)"},
+			{Name: "mount", Field: "Mount", Type: "mount", Default: "", Usage: "Attach a filesystem mount to the service"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Service name"},
+			{Name: "network", Field: "Network", Type: "network", Default: "", Usage: "Network attachments"},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "no-resolve-image", Field: "NoResolveImage", Type: "bool", Default: "false", Usage: "Do not query the registry to resolve image digest and supported platforms", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "placement-pref", Field: "PlacementPref", Type: "pref", Default: "", Usage: "Add a placement preference", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "publish", Shorthand: "p", Field: "Publish", Type: "port", Default: "", Usage: "Publish a port as a node port"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress progress output"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "replicas", Field: "Replicas", Type: "uint", Default: "", Usage: "Number of tasks"},
+			{Name: "replicas-max-per-node", Field: "ReplicasMaxPerNode", Type: "uint64", Default: "0", Usage: "Maximum number of tasks per node (default 0 = unlimited)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "reserve-cpu", Field: "ReserveCpu", Type: "decimal", Default: "", Usage: "Reserve CPUs"},
+			{Name: "reserve-memory", Field: "ReserveMemory", Type: "bytes", Default: "0", Usage: "Reserve Memory"},
+			{Name: "restart-condition", Field: "RestartCondition", Type: "string", Default: "", Usage: "Restart when condition is met (\"none\"|\"on-failure\"|\"any\") (default \"any\")"},
+			{Name: "restart-delay", Field: "RestartDelay", Type: "duration", Default: "", Usage: "Delay between restart attempts (ns|us|ms|s|m|h) (default 5s)"},
+			{Name: "restart-max-attempts", Field: "RestartMaxAttempts", Type: "uint", Default: "", Usage: "Maximum number of restarts before giving up"},
+			{Name: "restart-window", Field: "RestartWindow", Type: "duration", Default: "", Usage: "Window used to evaluate the restart policy (ns|us|ms|s|m|h)"},
+			{Name: "rollback-delay", Field: "RollbackDelay", Type: "duration", Default: "0s", Usage: "Delay between task rollbacks (ns|us|ms|s|m|h) (default 0s)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-failure-action", Field: "RollbackFailureAction", Type: "string", Default: "", Usage: "Action on rollback failure (\"pause\"|\"continue\") (default \"pause\")", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-max-failure-ratio", Field: "RollbackMaxFailureRatio", Type: "float", Default: "0", Usage: "Failure rate to tolerate during a rollback (default 0)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-monitor", Field: "RollbackMonitor", Type: "duration", Default: "0s", Usage: "Duration after each task rollback to monitor for failure (ns|us|ms|s|m|h) (default 5s)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-order", Field: "RollbackOrder", Type: "string", Default: "", Usage: "Rollback order (\"start-first\"|\"stop-first\") (default \"stop-first\")", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "rollback-parallelism", Field: "RollbackParallelism", Type: "uint64", Default: "1", Usage: "Maximum number of tasks rolled back simultaneously (0 to roll back all at once)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "secret", Field: "Secret", Type: "secret", Default: "", Usage: "Specify secrets to expose to the service", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "stop-grace-period", Field: "StopGracePeriod", Type: "duration", Default: "", Usage: "Time to wait before force killing a container (ns|us|ms|s|m|h) (default 10s)"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "", Usage: "Signal to stop the container", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "sysctl", Field: "Sysctl", Type: "list", Default: "", Usage: "Sysctl options", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "ulimit", Field: "Ulimit", Type: "ulimit", Default: "[]", Usage: "Ulimit options", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "update-delay", Field: "UpdateDelay", Type: "duration", Default: "0s", Usage: "Delay between updates (ns|us|ms|s|m|h) (default 0s)"},
+			{Name: "update-failure-action", Field: "UpdateFailureAction", Type: "string", Default: "", Usage: "Action on update failure (\"pause\"|\"continue\"|\"rollback\") (default \"pause\")"},
+			{Name: "update-max-failure-ratio", Field: "UpdateMaxFailureRatio", Type: "float", Default: "0", Usage: "Failure rate to tolerate during an update (default 0)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "update-monitor", Field: "UpdateMonitor", Type: "duration", Default: "0s", Usage: "Duration after each task update to monitor for failure (ns|us|ms|s|m|h) (default 5s)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "update-order", Field: "UpdateOrder", Type: "string", Default: "", Usage: "Update order (\"start-first\"|\"stop-first\") (default \"stop-first\")", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "update-parallelism", Field: "UpdateParallelism", Type: "uint64", Default: "1", Usage: "Maximum number of tasks updated simultaneously (0 to update all at once)"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "with-registry-auth", Field: "WithRegistryAuth", Type: "bool", Default: "false", Usage: "Send registry authentication details to swarm agents"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerServiceCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceCreateCmd(*opt.(*DockerServiceCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerServiceInspect",
+		Path:  []string{"docker", "service", "inspect"},
+		Use:   "inspect [OPTIONS] SERVICE [SERVICE...]",
+		Short: "Display detailed information on one or more services",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "pretty", Field: "Pretty", Type: "bool", Default: "false", Usage: "Print the information in a human friendly format"},
+		},
+		option: func() interface{} { return &DockerServiceInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceInspectCmd(*opt.(*DockerServiceInspectOption), args)
+		},
+	},
+	{
+		Name:        "DockerServiceLogs",
+		Path:        []string{"docker", "service", "logs"},
+		Use:         "logs [OPTIONS] SERVICE|TASK",
+		Short:       "Fetch the logs of a service or task",
+		Annotations: Annotations{MinAPIVersion: "1.29"},
+		Flags: []FlagInfo{
+			{Name: "details", Field: "Details", Type: "bool", Default: "false", Usage: "Show extra details provided to logs", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "follow", Shorthand: "f", Field: "Follow", Type: "bool", Default: "false", Usage: "Follow log output"},
+			{Name: "no-resolve", Field: "NoResolve", Type: "bool", Default: "false", Usage: "Do not map IDs to Names in output"},
+			{Name: "no-task-ids", Field: "NoTaskIds", Type: "bool", Default: "false", Usage: "Do not include task IDs in output"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+			{Name: "raw", Field: "Raw", Type: "bool", Default: "false", Usage: "Do not neatly format logs", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "since", Field: "Since", Type: "string", Default: "", Usage: "Show logs since timestamp (e.g. 2013-01-02T13:23:37Z) or relative (e.g. 42m for 42 minutes)"},
+			{Name: "tail", Shorthand: "n", Field: "Tail", Type: "string", Default: "all", Usage: "Number of lines to show from the end of the logs"},
+			{Name: "timestamps", Shorthand: "t", Field: "Timestamps", Type: "bool", Default: "false", Usage: "Show timestamps"},
+		},
+		option: func() interface{} { return &DockerServiceLogsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceLogsCmd(*opt.(*DockerServiceLogsOption), args)
+		},
+	},
+	{
+		Name:    "DockerServiceLs",
+		Path:    []string{"docker", "service", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List services",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print services using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display IDs"},
+		},
+		option: func() interface{} { return &DockerServiceLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceLsCmd(*opt.(*DockerServiceLsOption), args)
+		},
+	},
+	{
+		Name:  "DockerServicePs",
+		Path:  []string{"docker", "service", "ps"},
+		Use:   "ps [OPTIONS] SERVICE [SERVICE...]",
+		Short: "List the tasks of one or more services",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print tasks using a Go template"},
+			{Name: "no-resolve", Field: "NoResolve", Type: "bool", Default: "false", Usage: "Do not map IDs to Names"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display task IDs"},
+		},
+		option: func() interface{} { return &DockerServicePsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServicePsCmd(*opt.(*DockerServicePsOption), args)
+		},
+	},
+	{
+		Name:    "DockerServiceRm",
+		Path:    []string{"docker", "service", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm SERVICE [SERVICE...]",
+		Short:   "Remove one or more services",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerServiceRmCmd(args)
+		},
+	},
+	{
+		Name:        "DockerServiceRollback",
+		Path:        []string{"docker", "service", "rollback"},
+		Use:         "rollback [OPTIONS] SERVICE",
+		Short:       "Revert changes to a service's configuration",
+		Annotations: Annotations{MinAPIVersion: "1.31"},
+		Flags: []FlagInfo{
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Exit immediately instead of waiting for the service to converge", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress progress output"},
+		},
+		option: func() interface{} { return &DockerServiceRollbackOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceRollbackCmd(*opt.(*DockerServiceRollbackOption), args)
+		},
+	},
+	{
+		Name:  "DockerServiceScale",
+		Path:  []string{"docker", "service", "scale"},
+		Use:   "scale SERVICE=REPLICAS [SERVICE=REPLICAS...]",
+		Short: "Scale one or multiple replicated services",
+		Flags: []FlagInfo{
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Exit immediately instead of waiting for the service to converge", Annotations: Annotations{MinAPIVersion: "1.29"}},
+		},
+		option: func() interface{} { return &DockerServiceScaleOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceScaleCmd(*opt.(*DockerServiceScaleOption), args)
+		},
+	},
+	{
+		Name:  "DockerServiceUpdate",
+		Path:  []string{"docker", "service", "update"},
+		Use:   "update [OPTIONS] SERVICE",
+		Short: "Update a service",
+		Flags: []FlagInfo{
+			{Name: "args", Field: "Args", Type: "command", Default: "", Usage: "Service command args"},
+			{Name: "cap-add", Field: "CapAdd", Type: "list", Default: "", Usage: "Add Linux capabilities", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "cap-drop", Field: "CapDrop", Type: "list", Default: "", Usage: "Drop Linux capabilities", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "config-add", Field: "ConfigAdd", Type: "config", Default: "", Usage: "Add or update a config file on a service", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "config-rm", Field: "ConfigRm", Type: "list", Default: "", Usage: "Remove a configuration file", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "constraint-add", Field: "ConstraintAdd", Type: "list", Default: "", Usage: "Add or update a placement constraint"},
+			{Name: "constraint-rm", Field: "ConstraintRm", Type: "list", Default: "", Usage: "Remove a constraint"},
+			{Name: "container-label-add", Field: "ContainerLabelAdd", Type: "list", Default: "", Usage: "Add or update a container label"},
+			{Name: "container-label-rm", Field: "ContainerLabelRm", Type: "list", Default: "", Usage: "Remove a container label by its key"},
+			{Name: "credential-spec", Field: "CredentialSpec", Type: "credential-spec", Default: "", Usage: "Credential spec for managed service account (Windows only)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Exit immediately instead of waiting for the service to converge", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "dns-add", Field: "DnsAdd", Type: "list", Default: "", Usage: "Add or update a custom DNS server", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-option-add", Field: "DnsOptionAdd", Type: "list", Default: "", Usage: "Add or update a DNS option", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-option-rm", Field: "DnsOptionRm", Type: "list", Default: "", Usage: "Remove a DNS option", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-rm", Field: "DnsRm", Type: "list", Default: "", Usage: "Remove a custom DNS server", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-search-add", Field: "DnsSearchAdd", Type: "list", Default: "", Usage: "Add or update a custom DNS search domain", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "dns-search-rm", Field: "DnsSearchRm", Type: "list", Default: "", Usage: "Remove a DNS search domain", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "endpoint-mode", Field: "EndpointMode", Type: "string", Default: "vip", Usage: "Endpoint mode (vip or dnsrr)"},
+			{Name: "entrypoint", Field: "Entrypoint", Type: "command", Default: "", Usage: "Overwrite the default ENTRYPOINT of the image"},
+			{Name: "env-add", Field: "EnvAdd", Type: "list", Default: "", Usage: "Add or update an environment variable"},
+			{Name: "env-rm", Field: "EnvRm", Type: "list", Default: "", Usage: "Remove an environment variable"},
+			{Name: "force", Field: "Force", Type: "bool", Default: "false", Usage: "Force update even if no changes require it", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "generic-resource-add", Field: "GenericResourceAdd", Type: "list", Default: "", Usage: "Add a Generic resource"},
+			{Name: "generic-resource-rm", Field: "GenericResourceRm", Type: "list", Default: "", Usage: "Remove a Generic resource"},
+			{Name: "group-add", Field: "GroupAdd", Type: "list", Default: "", Usage: "Add an additional supplementary user group to the container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "group-rm", Field: "GroupRm", Type: "list", Default: "", Usage: "Remove a previously added supplementary user group from the container", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-cmd", Field: "HealthCmd", Type: "string", Default: "", Usage: "Command to run to check health", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-interval", Field: "HealthInterval", Type: "duration", Default: "", Usage: "Time between running the check (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-retries", Field: "HealthRetries", Type: "int", Default: "0", Usage: "Consecutive failures needed to report unhealthy", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "health-start-period", Field: "HealthStartPeriod", Type: "duration", Default: "", Usage: "Start period for the container to initialize before counting retries towards unstable (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "health-timeout", Field: "HealthTimeout", Type: "duration", Default: "", Usage: "Maximum time to allow one check to run (ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "host-add", Field: "HostAdd", Type: "list", Default: "", Usage: "Add a custom host-to-IP mapping (host:ip)", Annotations: Annotations{MinAPIVersion: "1.32"}},
+			{Name: "host-rm", Field: "HostRm", Type: "list", Default: "", Usage: "Remove a custom host-to-IP mapping (host:ip)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "hostname", Field: "Hostname", Type: "string", Default: "", Usage: "Container hostname", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "image", Field: "Image", Type: "string", Default: "", Usage: "Service image tag"},
+			{Name: "init", Field: "Init", Type: "bool", Default: "false", Usage: "Use an init inside each service container to forward signals and reap processes", Annotations: Annotations{MinAPIVersion: "1.37"}},
+			{Name: "isolation", Field: "Isolation", Type: "string", Default: "", Usage: "Service container isolation mode", Annotations: Annotations{MinAPIVersion: "1.35"}},
+			{Name: "label-add", Field: "LabelAdd", Type: "list", Default: "", Usage: "Add or update a service label"},
+			{Name: "label-rm", Field: "LabelRm", Type: "list", Default: "", Usage: "Remove a label by its key"},
+			{Name: "limit-cpu", Field: "LimitCpu", Type: "decimal", Default: "", Usage: "Limit CPUs"},
+			{Name: "limit-memory", Field: "LimitMemory", Type: "bytes", Default: "0", Usage: "Limit Memory"},
+			{Name: "limit-pids", Field: "LimitPids", Type: "int64", Default: "0", Usage: "Limit maximum number of processes (default 0 = unlimited)", Annotations: Annotations{MinAPIVersion: "1.41", Swarm: true}},
+			{Name: "log-driver", Field: "LogDriver", Type: "string", Default: "", Usage: "Logging driver for service"},
+			{Name: "log-opt", Field: "LogOpt", Type: "list", Default: "", Usage: "Logging driver options"},
+			{Name: "max-concurrent", Field: "MaxConcurrent", Type: "uint", Default: "", Usage: "Number of job tasks to run concurrently (default equal to --replicas)", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "mount-add", Field: "MountAdd", Type: "mount", Default: "", Usage: "Add or update a mount on a service"},
+			{Name: "mount-rm", Field: "MountRm", Type: "list", Default: "", Usage: "Remove a mount by its target path"},
+			{Name: "network-add", Field: "NetworkAdd", Type: "network", Default: "", Usage: "Add a network", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "network-rm", Field: "NetworkRm", Type: "list", Default: "", Usage: "Remove a network", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "no-healthcheck", Field: "NoHealthcheck", Type: "bool", Default: "false", Usage: "Disable any container-specified HEALTHCHECK", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "no-resolve-image", Field: "NoResolveImage", Type: "bool", Default: "false", Usage: "Do not query the registry to resolve image digest and supported platforms", Annotations: Annotations{MinAPIVersion: "1.30"}},
+			{Name: "placement-pref-add", Field: "PlacementPrefAdd", Type: "pref", Default: "", Usage: "Add a placement preference", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "placement-pref-rm", Field: "PlacementPrefRm", Type: "pref", Default: "", Usage: "Remove a placement preference", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "publish-add", Field: "PublishAdd", Type: "port", Default: "", Usage: "Add or update a published port"},
+			{Name: "publish-rm", Field: "PublishRm", Type: "port", Default: "", Usage: "Remove a published port by its target port"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress progress output"},
+			{Name: "read-only", Field: "ReadOnly", Type: "bool", Default: "false", Usage: "Mount the container's root filesystem as read only", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "replicas", Field: "Replicas", Type: "uint", Default: "", Usage: "Number of tasks"},
+			{Name: "replicas-max-per-node", Field: "ReplicasMaxPerNode", Type: "uint64", Default: "0", Usage: "Maximum number of tasks per node (default 0 = unlimited)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "reserve-cpu", Field: "ReserveCpu", Type: "decimal", Default: "", Usage: "Reserve CPUs"},
+			{Name: "reserve-memory", Field: "ReserveMemory", Type: "bytes", Default: "0", Usage: "Reserve Memory"},
+			{Name: "restart-condition", Field: "RestartCondition", Type: "string", Default: "", Usage: "Restart when condition is met (\"none\"|\"on-failure\"|\"any\")"},
+			{Name: "restart-delay", Field: "RestartDelay", Type: "duration", Default: "", Usage: "Delay between restart attempts (ns|us|ms|s|m|h)"},
+			{Name: "restart-max-attempts", Field: "RestartMaxAttempts", Type: "uint", Default: "", Usage: "Maximum number of restarts before giving up"},
+			{Name: "restart-window", Field: "RestartWindow", Type: "duration", Default: "", Usage: "Window used to evaluate the restart policy (ns|us|ms|s|m|h)"},
+			{Name: "rollback", Field: "Rollback", Type: "bool", Default: "false", Usage: "Rollback to previous specification", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "rollback-delay", Field: "RollbackDelay", Type: "duration", Default: "0s", Usage: "Delay between task rollbacks (ns|us|ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-failure-action", Field: "RollbackFailureAction", Type: "string", Default: "", Usage: "Action on rollback failure (\"pause\"|\"continue\")", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-max-failure-ratio", Field: "RollbackMaxFailureRatio", Type: "float", Default: "0", Usage: "Failure rate to tolerate during a rollback", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-monitor", Field: "RollbackMonitor", Type: "duration", Default: "0s", Usage: "Duration after each task rollback to monitor for failure (ns|us|ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "rollback-order", Field: "RollbackOrder", Type: "string", Default: "", Usage: "Rollback order (\"start-first\"|\"stop-first\")", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "rollback-parallelism", Field: "RollbackParallelism", Type: "uint64", Default: "1", Usage: "Maximum number of tasks rolled back simultaneously (0 to roll back all at once)", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "secret-add", Field: "SecretAdd", Type: "secret", Default: "", Usage: "Add or update a secret on a service", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "secret-rm", Field: "SecretRm", Type: "list", Default: "", Usage: "Remove a secret", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "stop-grace-period", Field: "StopGracePeriod", Type: "duration", Default: "", Usage: "Time to wait before force killing a container (ns|us|ms|s|m|h)"},
+			{Name: "stop-signal", Field: "StopSignal", Type: "string", Default: "", Usage: "Signal to stop the container", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "sysctl-add", Field: "SysctlAdd", Type: "list", Default: "", Usage: "Add or update a Sysctl option", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "sysctl-rm", Field: "SysctlRm", Type: "list", Default: "", Usage: "Remove a Sysctl option", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "tty", Shorthand: "t", Field: "Tty", Type: "bool", Default: "false", Usage: "Allocate a pseudo-TTY", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "ulimit-add", Field: "UlimitAdd", Type: "ulimit", Default: "[]", Usage: "Add or update a ulimit option", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "ulimit-rm", Field: "UlimitRm", Type: "list", Default: "", Usage: "Remove a ulimit option", Annotations: Annotations{MinAPIVersion: "1.41"}},
+			{Name: "update-delay", Field: "UpdateDelay", Type: "duration", Default: "0s", Usage: "Delay between updates (ns|us|ms|s|m|h)"},
+			{Name: "update-failure-action", Field: "UpdateFailureAction", Type: "string", Default: "", Usage: "Action on update failure (\"pause\"|\"continue\"|\"rollback\")"},
+			{Name: "update-max-failure-ratio", Field: "UpdateMaxFailureRatio", Type: "float", Default: "0", Usage: "Failure rate to tolerate during an update", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "update-monitor", Field: "UpdateMonitor", Type: "duration", Default: "0s", Usage: "Duration after each task update to monitor for failure (ns|us|ms|s|m|h)", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "update-order", Field: "UpdateOrder", Type: "string", Default: "", Usage: "Update order (\"start-first\"|\"stop-first\")", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "update-parallelism", Field: "UpdateParallelism", Type: "uint64", Default: "1", Usage: "Maximum number of tasks updated simultaneously (0 to update all at once)"},
+			{Name: "user", Shorthand: "u", Field: "User", Type: "string", Default: "", Usage: "Username or UID (format: <name|uid>[:<group|gid>])"},
+			{Name: "with-registry-auth", Field: "WithRegistryAuth", Type: "bool", Default: "false", Usage: "Send registry authentication details to swarm agents"},
+			{Name: "workdir", Shorthand: "w", Field: "Workdir", Type: "string", Default: "", Usage: "Working directory inside the container"},
+		},
+		option: func() interface{} { return &DockerServiceUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerServiceUpdateCmd(*opt.(*DockerServiceUpdateOption), args)
+		},
+	},
+	{
+		Name:        "DockerStack",
+		Path:        []string{"docker", "stack"},
+		Use:         "stack [OPTIONS]",
+		Short:       "Manage Docker stacks",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerStackCmd(args)
+		},
+	},
+	{
+		Name:    "DockerStackDeploy",
+		Path:    []string{"docker", "stack", "deploy"},
+		Aliases: []string{"up"},
+		Use:     "deploy [OPTIONS] STACK",
+		Short:   "Deploy a new stack or update an existing stack",
+		Flags: []FlagInfo{
+			{Name: "compose-file", Shorthand: "c", Field: "ComposeFile", Type: "stringSlice", Default: "[]", Usage: "Path to a Compose file, or \"-\" to read from stdin", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "namespace", Field: "Namespace", Type: "string", Default: "", Usage: "Kubernetes namespace to use", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+			{Name: "prune", Field: "Prune", Type: "bool", Default: "false", Usage: "Prune services that are no longer referenced", Annotations: Annotations{MinAPIVersion: "1.27", Swarm: true}},
+			{Name: "resolve-image", Field: "ResolveImage", Type: "string", Default: "always", Usage: "Query the registry to resolve image digest and supported platforms (\"always\"|\"changed\"|\"never\")", Annotations: Annotations{MinAPIVersion: "1.30", Swarm: true}},
+			{Name: "with-registry-auth", Field: "WithRegistryAuth", Type: "bool", Default: "false", Usage: "Send registry authentication details to Swarm agents", Annotations: Annotations{Swarm: true}},
+		},
+		option: func() interface{} { return &DockerStackDeployOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStackDeployCmd(*opt.(*DockerStackDeployOption), args)
+		},
+	},
+	{
+		Name:    "DockerStackLs",
+		Path:    []string{"docker", "stack", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List stacks",
+		Flags: []FlagInfo{
+			{Name: "all-namespaces", Field: "AllNamespaces", Type: "bool", Default: "false", Usage: "List stacks from all Kubernetes namespaces", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print stacks using a Go template"},
+			{Name: "namespace", Field: "Namespace", Type: "stringSlice", Default: "[]", Usage: "Kubernetes namespaces to use", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerStackLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStackLsCmd(*opt.(*DockerStackLsOption), args)
+		},
+	},
+	{
+		Name:  "DockerStackPs",
+		Path:  []string{"docker", "stack", "ps"},
+		Use:   "ps [OPTIONS] STACK",
+		Short: "List the tasks in the stack",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print tasks using a Go template"},
+			{Name: "namespace", Field: "Namespace", Type: "string", Default: "", Usage: "Kubernetes namespace to use", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+			{Name: "no-resolve", Field: "NoResolve", Type: "bool", Default: "false", Usage: "Do not map IDs to Names"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display task IDs"},
+		},
+		option: func() interface{} { return &DockerStackPsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStackPsCmd(*opt.(*DockerStackPsOption), args)
+		},
+	},
+	{
+		Name:    "DockerStackRm",
+		Path:    []string{"docker", "stack", "rm"},
+		Aliases: []string{"remove", "down"},
+		Use:     "rm [OPTIONS] STACK [STACK...]",
+		Short:   "Remove one or more stacks",
+		Flags: []FlagInfo{
+			{Name: "namespace", Field: "Namespace", Type: "string", Default: "", Usage: "Kubernetes namespace to use", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerStackRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStackRmCmd(*opt.(*DockerStackRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerStackServices",
+		Path:  []string{"docker", "stack", "services"},
+		Use:   "services [OPTIONS] STACK",
+		Short: "List the services in the stack",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print services using a Go template"},
+			{Name: "namespace", Field: "Namespace", Type: "string", Default: "", Usage: "Kubernetes namespace to use", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display IDs"},
+		},
+		option: func() interface{} { return &DockerStackServicesOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStackServicesCmd(*opt.(*DockerStackServicesOption), args)
+		},
+	},
+	{
+		Name:  "DockerStart",
+		Path:  []string{"docker", "start"},
+		Use:   "start [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Start one or more stopped containers",
+		Flags: []FlagInfo{
+			{Name: "attach", Shorthand: "a", Field: "Attach", Type: "bool", Default: "false", Usage: "Attach STDOUT/STDERR and forward signals"},
+			{Name: "checkpoint", Field: "Checkpoint", Type: "string", Default: "", Usage: "Restore from this checkpoint", Annotations: Annotations{OSType: "linux", Experimental: true}},
+			{Name: "checkpoint-dir", Field: "CheckpointDir", Type: "string", Default: "", Usage: "Use a custom checkpoint storage directory", Annotations: Annotations{OSType: "linux", Experimental: true}},
+			{Name: "detach-keys", Field: "DetachKeys", Type: "string", Default: "", Usage: "Override the key sequence for detaching a container"},
+			{Name: "interactive", Shorthand: "i", Field: "Interactive", Type: "bool", Default: "false", Usage: "Attach container's STDIN"},
+		},
+		option: func() interface{} { return &DockerStartOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStartCmd(*opt.(*DockerStartOption), args)
+		},
+	},
+	{
+		Name:  "DockerStats",
+		Path:  []string{"docker", "stats"},
+		Use:   "stats [OPTIONS] [CONTAINER...]",
+		Short: "Display a live stream of container(s) resource usage statistics",
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Show all containers (default shows just running)"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "no-stream", Field: "NoStream", Type: "bool", Default: "false", Usage: "Disable streaming stats and only pull the first result"},
+			{Name: "no-trunc", Field: "NoTrunc", Type: "bool", Default: "false", Usage: "Do not truncate output"},
+		},
+		option: func() interface{} { return &DockerStatsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStatsCmd(*opt.(*DockerStatsOption), args)
+		},
+	},
+	{
+		Name:  "DockerStop",
+		Path:  []string{"docker", "stop"},
+		Use:   "stop [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Stop one or more running containers",
+		Flags: []FlagInfo{
+			{Name: "time", Shorthand: "t", Field: "Time", Type: "int", Default: "10", Usage: "Seconds to wait for stop before killing it"},
+		},
+		option: func() interface{} { return &DockerStopOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerStopCmd(*opt.(*DockerStopOption), args)
+		},
+	},
+	{
+		Name:        "DockerSwarm",
+		Path:        []string{"docker", "swarm"},
+		Use:         "swarm",
+		Short:       "Manage Swarm",
+		Annotations: Annotations{MinAPIVersion: "1.24", Swarm: true},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSwarmCmd(args)
+		},
+	},
+	{
+		Name:        "DockerSwarmCa",
+		Path:        []string{"docker", "swarm", "ca"},
+		Use:         "ca [OPTIONS]",
+		Short:       "Display and rotate the root CA",
+		Annotations: Annotations{MinAPIVersion: "1.30"},
+		Flags: []FlagInfo{
+			{Name: "ca-cert", Field: "CaCert", Type: "pem-file", Default: "", Usage: "Path to the PEM-formatted root CA certificate to use for the new cluster"},
+			{Name: "ca-key", Field: "CaKey", Type: "pem-file", Default: "", Usage: "Path to the PEM-formatted root CA key to use for the new cluster"},
+			{Name: "cert-expiry", Field: "CertExpiry", Type: "duration", Default: "2160h0m0s", Usage: "Validity period for node certificates (ns|us|ms|s|m|h)"},
+			{Name: "detach", Shorthand: "d", Field: "Detach", Type: "bool", Default: "false", Usage: "Exit immediately instead of waiting for the root rotation to converge"},
+			{Name: "external-ca", Field: "ExternalCa", Type: "external-ca", Default: "", Usage: "Specifications of one or more certificate signing endpoints"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Suppress progress output"},
+			{Name: "rotate", Field: "Rotate", Type: "bool", Default: "false", Usage: "Rotate the swarm CA - if no certificate or key are provided, new ones will be generated"},
+		},
+		option: func() interface{} { return &DockerSwarmCaOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmCaCmd(*opt.(*DockerSwarmCaOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmInit",
+		Path:  []string{"docker", "swarm", "init"},
+		Use:   "init [OPTIONS]",
+		Short: "Initialize a swarm",
+		Flags: []FlagInfo{
+			{Name: "advertise-addr", Field: "AdvertiseAddr", Type: "string", Default: "", Usage: "Advertised address (format: <ip|interface>[:port])"},
+			{Name: "autolock", Field: "Autolock", Type: "bool", Default: "false", Usage: "Enable manager autolocking (requiring an unlock key to start a stopped manager)"},
+			{Name: "availability", Field: "Availability", Type: "string", Default: "active", Usage: "Availability of the node (\"active\"|\"pause\"|\"drain\")"},
+			{Name: "cert-expiry", Field: "CertExpiry", Type: "duration", Default: "2160h0m0s", Usage: "Validity period for node certificates (ns|us|ms|s|m|h)"},
+			{Name: "data-path-addr", Field: "DataPathAddr", Type: "string", Default: "", Usage: "Address or interface to use for data path traffic (format: <ip|interface>)", Annotations: Annotations{MinAPIVersion: "1.31"}},
+			{Name: "data-path-port", Field: "DataPathPort", Type: "uint32", Default: "0", Usage: "Port number to use for data path traffic (1024 - 49151). If no value is set or is set to 0, the default port (4789) is used.", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "default-addr-pool", Field: "DefaultAddrPool", Type: "ipNetSlice", Default: "[]", Usage: "default address pool in CIDR format", Annotations: Annotations{MinAPIVersion: "1.39"}},
+			{Name: "default-addr-pool-mask-length", Field: "DefaultAddrPoolMaskLength", Type: "uint32", Default: "24", Usage: "default address pool subnet mask length", Annotations: Annotations{MinAPIVersion: "1.39"}},
+			{Name: "dispatcher-heartbeat", Field: "DispatcherHeartbeat", Type: "duration", Default: "5s", Usage: "Dispatcher heartbeat period (ns|us|ms|s|m|h)"},
+			{Name: "external-ca", Field: "ExternalCa", Type: "external-ca", Default: "", Usage: "Specifications of one or more certificate signing endpoints"},
+			{Name: "force-new-cluster", Field: "ForceNewCluster", Type: "bool", Default: "false", Usage: "Force create a new cluster from current state"},
+			{Name: "listen-addr", Field: "ListenAddr", Type: "node-addr", Default: "0.0.0.0:2377", Usage: "Listen address (format: <ip|interface>[:port])"},
+			{Name: "max-snapshots", Field: "MaxSnapshots", Type: "uint64", Default: "0", Usage: "Number of additional Raft snapshots to retain", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "snapshot-interval", Field: "SnapshotInterval", Type: "uint64", Default: "10000", Usage: "Number of log entries between Raft snapshots", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "task-history-limit", Field: "TaskHistoryLimit", Type: "int64", Default: "5", Usage: "Task history retention limit"},
+		},
+		option: func() interface{} { return &DockerSwarmInitOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmInitCmd(*opt.(*DockerSwarmInitOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmJoin",
+		Path:  []string{"docker", "swarm", "join"},
+		Use:   "join [OPTIONS] HOST:PORT",
+		Short: "Join a swarm as a node and/or manager",
+		Flags: []FlagInfo{
+			{Name: "advertise-addr", Field: "AdvertiseAddr", Type: "string", Default: "", Usage: "Advertised address (format: <ip|interface>[:port])"},
+			{Name: "availability", Field: "Availability", Type: "string", Default: "active", Usage: "Availability of the node (\"active\"|\"pause\"|\"drain\")"},
+			{Name: "data-path-addr", Field: "DataPathAddr", Type: "string", Default: "", Usage: "Address or interface to use for data path traffic (format: <ip|interface>)", Annotations: Annotations{MinAPIVersion: "1.31"}},
+			{Name: "listen-addr", Field: "ListenAddr", Type: "node-addr", Default: "0.0.0.0:2377", Usage: "Listen address (format: <ip|interface>[:port])"},
+			{Name: "token", Field: "Token", Type: "string", Default: "", Usage: "Token for entry into the swarm"},
+		},
+		option: func() interface{} { return &DockerSwarmJoinOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmJoinCmd(*opt.(*DockerSwarmJoinOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmJoinToken",
+		Path:  []string{"docker", "swarm", "join-token"},
+		Use:   "join-token [OPTIONS] (worker|manager)",
+		Short: "Manage join tokens",
+		Flags: []FlagInfo{
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display token"},
+			{Name: "rotate", Field: "Rotate", Type: "bool", Default: "false", Usage: "Rotate join token"},
+		},
+		option: func() interface{} { return &DockerSwarmJoinTokenOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmJoinTokenCmd(*opt.(*DockerSwarmJoinTokenOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmLeave",
+		Path:  []string{"docker", "swarm", "leave"},
+		Use:   "leave [OPTIONS]",
+		Short: "Leave the swarm",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force this node to leave the swarm, ignoring warnings"},
+		},
+		option: func() interface{} { return &DockerSwarmLeaveOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmLeaveCmd(*opt.(*DockerSwarmLeaveOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmUnlock",
+		Path:  []string{"docker", "swarm", "unlock"},
+		Use:   "unlock",
+		Short: "Unlock swarm",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSwarmUnlockCmd(args)
+		},
+	},
+	{
+		Name:  "DockerSwarmUnlockKey",
+		Path:  []string{"docker", "swarm", "unlock-key"},
+		Use:   "unlock-key [OPTIONS]",
+		Short: "Manage the unlock key",
+		Flags: []FlagInfo{
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display token"},
+			{Name: "rotate", Field: "Rotate", Type: "bool", Default: "false", Usage: "Rotate unlock key"},
+		},
+		option: func() interface{} { return &DockerSwarmUnlockKeyOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmUnlockKeyCmd(*opt.(*DockerSwarmUnlockKeyOption), args)
+		},
+	},
+	{
+		Name:  "DockerSwarmUpdate",
+		Path:  []string{"docker", "swarm", "update"},
+		Use:   "update [OPTIONS]",
+		Short: "Update the swarm",
+		Flags: []FlagInfo{
+			{Name: "autolock", Field: "Autolock", Type: "bool", Default: "false", Usage: "Change manager autolocking setting (true|false)"},
+			{Name: "cert-expiry", Field: "CertExpiry", Type: "duration", Default: "2160h0m0s", Usage: "Validity period for node certificates (ns|us|ms|s|m|h)"},
+			{Name: "dispatcher-heartbeat", Field: "DispatcherHeartbeat", Type: "duration", Default: "5s", Usage: "Dispatcher heartbeat period (ns|us|ms|s|m|h)"},
+			{Name: "external-ca", Field: "ExternalCa", Type: "external-ca", Default: "", Usage: "Specifications of one or more certificate signing endpoints"},
+			{Name: "max-snapshots", Field: "MaxSnapshots", Type: "uint64", Default: "0", Usage: "Number of additional Raft snapshots to retain", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "snapshot-interval", Field: "SnapshotInterval", Type: "uint64", Default: "10000", Usage: "Number of log entries between Raft snapshots", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "task-history-limit", Field: "TaskHistoryLimit", Type: "int64", Default: "5", Usage: "Task history retention limit"},
+		},
+		option: func() interface{} { return &DockerSwarmUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSwarmUpdateCmd(*opt.(*DockerSwarmUpdateOption), args)
+		},
+	},
+	{
+		Name:  "DockerSystem",
+		Path:  []string{"docker", "system"},
+		Use:   "system",
+		Short: "Manage Docker",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSystemCmd(args)
+		},
+	},
+	{
+		Name:        "DockerSystemDf",
+		Path:        []string{"docker", "system", "df"},
+		Use:         "df [OPTIONS]",
+		Short:       "Show docker disk usage",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print images using a Go template"},
+			{Name: "verbose", Shorthand: "v", Field: "Verbose", Type: "bool", Default: "false", Usage: "Show detailed information on space usage"},
+		},
+		option: func() interface{} { return &DockerSystemDfOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSystemDfCmd(*opt.(*DockerSystemDfOption), args)
+		},
+	},
+	{
+		Name:   "DockerSystemDialStdio",
+		Path:   []string{"docker", "system", "dial-stdio"},
+		Use:    "dial-stdio",
+		Short:  "Proxy the stdio stream to the daemon connection. Should not be invoked manually.",
+		Hidden: true,
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerSystemDialStdioCmd(args)
+		},
+	},
+	{
+		Name:  "DockerSystemEvents",
+		Path:  []string{"docker", "system", "events"},
+		Use:   "events [OPTIONS]",
+		Short: "Get real time events from the server",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Filter output based on conditions provided"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "since", Field: "Since", Type: "string", Default: "", Usage: "Show all events created since timestamp"},
+			{Name: "until", Field: "Until", Type: "string", Default: "", Usage: "Stream events until this timestamp"},
+		},
+		option: func() interface{} { return &DockerSystemEventsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSystemEventsCmd(*opt.(*DockerSystemEventsOption), args)
+		},
+	},
+	{
+		Name:  "DockerSystemInfo",
+		Path:  []string{"docker", "system", "info"},
+		Use:   "info [OPTIONS]",
+		Short: "Display system-wide information",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerSystemInfoOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSystemInfoCmd(*opt.(*DockerSystemInfoOption), args)
+		},
+	},
+	{
+		Name:        "DockerSystemPrune",
+		Path:        []string{"docker", "system", "prune"},
+		Use:         "prune [OPTIONS]",
+		Short:       "Remove unused data",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "all", Shorthand: "a", Field: "All", Type: "bool", Default: "false", Usage: "Remove all unused images not just dangling ones"},
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'label=<key>=<value>')", Annotations: Annotations{MinAPIVersion: "1.28"}},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+			{Name: "volumes", Field: "Volumes", Type: "bool", Default: "false", Usage: "Prune volumes"},
+		},
+		option: func() interface{} { return &DockerSystemPruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerSystemPruneCmd(*opt.(*DockerSystemPruneOption), args)
+		},
+	},
+	{
+		Name:  "DockerTag",
+		Path:  []string{"docker", "tag"},
+		Use:   "tag SOURCE_IMAGE[:TAG] TARGET_IMAGE[:TAG]",
+		Short: "Create a tag TARGET_IMAGE that refers to SOURCE_IMAGE",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerTagCmd(args)
+		},
+	},
+	{
+		Name:  "DockerTop",
+		Path:  []string{"docker", "top"},
+		Use:   "top CONTAINER [ps OPTIONS]",
+		Short: "Display the running processes of a container",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerTopCmd(args)
+		},
+	},
+	{
+		Name:  "DockerTrust",
+		Path:  []string{"docker", "trust"},
+		Use:   "trust",
+		Short: "Manage trust on Docker images",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerTrustCmd(args)
+		},
+	},
+	{
+		Name:  "DockerTrustInspect",
+		Path:  []string{"docker", "trust", "inspect"},
+		Use:   "inspect IMAGE[:TAG] [IMAGE[:TAG]...]",
+		Short: "Return low-level information about keys and signatures",
+		Flags: []FlagInfo{
+			{Name: "pretty", Field: "Pretty", Type: "bool", Default: "false", Usage: "Print the information in a human friendly format"},
+		},
+		option: func() interface{} { return &DockerTrustInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustInspectCmd(*opt.(*DockerTrustInspectOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustKey",
+		Path:  []string{"docker", "trust", "key"},
+		Use:   "key",
+		Short: "Manage keys for signing Docker images",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerTrustKeyCmd(args)
+		},
+	},
+	{
+		Name:  "DockerTrustKeyGenerate",
+		Path:  []string{"docker", "trust", "key", "generate"},
+		Use:   "generate NAME",
+		Short: "Generate and load a signing key-pair",
+		Flags: []FlagInfo{
+			{Name: "dir", Field: "Dir", Type: "string", Default: "", Usage: "Directory to generate key in, defaults to current directory"},
+		},
+		option: func() interface{} { return &DockerTrustKeyGenerateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustKeyGenerateCmd(*opt.(*DockerTrustKeyGenerateOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustKeyLoad",
+		Path:  []string{"docker", "trust", "key", "load"},
+		Use:   "load [OPTIONS] KEYFILE",
+		Short: "Load a private key file for signing",
+		Flags: []FlagInfo{
+			{Name: "name", Field: "Name", Type: "string", Default: "signer", Usage: "Name for the loaded key"},
+		},
+		option: func() interface{} { return &DockerTrustKeyLoadOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustKeyLoadCmd(*opt.(*DockerTrustKeyLoadOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustRevoke",
+		Path:  []string{"docker", "trust", "revoke"},
+		Use:   "revoke [OPTIONS] IMAGE[:TAG]",
+		Short: "Remove trust for an image",
+		Flags: []FlagInfo{
+			{Name: "yes", Shorthand: "y", Field: "Yes", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+		},
+		option: func() interface{} { return &DockerTrustRevokeOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustRevokeCmd(*opt.(*DockerTrustRevokeOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustSign",
+		Path:  []string{"docker", "trust", "sign"},
+		Use:   "sign IMAGE:TAG",
+		Short: "Sign an image",
+		Flags: []FlagInfo{
+			{Name: "local", Field: "Local", Type: "bool", Default: "false", Usage: "Sign a locally tagged image"},
+		},
+		option: func() interface{} { return &DockerTrustSignOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustSignCmd(*opt.(*DockerTrustSignOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustSigner",
+		Path:  []string{"docker", "trust", "signer"},
+		Use:   "signer",
+		Short: "Manage entities who can sign Docker images",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerTrustSignerCmd(args)
+		},
+	},
+	{
+		Name:  "DockerTrustSignerAdd",
+		Path:  []string{"docker", "trust", "signer", "add"},
+		Use:   "add OPTIONS NAME REPOSITORY [REPOSITORY...]",
+		Short: "Add a signer",
+		Flags: []FlagInfo{
+			{Name: "key", Field: "Key", Type: "list", Default: "", Usage: "Path to the signer's public key file"},
+		},
+		option: func() interface{} { return &DockerTrustSignerAddOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustSignerAddCmd(*opt.(*DockerTrustSignerAddOption), args)
+		},
+	},
+	{
+		Name:  "DockerTrustSignerRemove",
+		Path:  []string{"docker", "trust", "signer", "remove"},
+		Use:   "remove [OPTIONS] NAME REPOSITORY [REPOSITORY...]",
+		Short: "Remove a signer",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation before removing the most recent signer"},
+		},
+		option: func() interface{} { return &DockerTrustSignerRemoveOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerTrustSignerRemoveCmd(*opt.(*DockerTrustSignerRemoveOption), args)
+		},
+	},
+	{
+		Name:  "DockerUnpause",
+		Path:  []string{"docker", "unpause"},
+		Use:   "unpause CONTAINER [CONTAINER...]",
+		Short: "Unpause all processes within one or more containers",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerUnpauseCmd(args)
+		},
+	},
+	{
+		Name:  "DockerUpdate",
+		Path:  []string{"docker", "update"},
+		Use:   "update [OPTIONS] CONTAINER [CONTAINER...]",
+		Short: "Update configuration of one or more containers",
+		Flags: []FlagInfo{
+			{Name: "blkio-weight", Field: "BlkioWeight", Type: "uint16", Default: "0", Usage: "Block IO (relative weight), between 10 and 1000, or 0 to disable (default 0)"},
+			{Name: "cpu-period", Field: "CpuPeriod", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) period"},
+			{Name: "cpu-quota", Field: "CpuQuota", Type: "int64", Default: "0", Usage: "Limit CPU CFS (Completely Fair Scheduler) quota"},
+			{Name: "cpu-rt-period", Field: "CpuRtPeriod", Type: "int64", Default: "0", Usage: "Limit the CPU real-time period in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-rt-runtime", Field: "CpuRtRuntime", Type: "int64", Default: "0", Usage: "Limit the CPU real-time runtime in microseconds", Annotations: Annotations{MinAPIVersion: "1.25"}},
+			{Name: "cpu-shares", Shorthand: "c", Field: "CpuShares", Type: "int64", Default: "0", Usage: "CPU shares (relative weight)"},
+			{Name: "cpus", Field: "Cpus", Type: "decimal", Default: "", Usage: "Number of CPUs", Annotations: Annotations{MinAPIVersion: "1.29"}},
+			{Name: "cpuset-cpus", Field: "CpusetCpus", Type: "string", Default: "", Usage: "CPUs in which to allow execution (0-3, 0,1)"},
+			{Name: "cpuset-mems", Field: "CpusetMems", Type: "string", Default: "", Usage: "MEMs in which to allow execution (0-3, 0,1)"},
+			{Name: "kernel-memory", Field: "KernelMemory", Type: "bytes", Default: "0", Usage: "Kernel memory limit", Deprecated: "kernel memory limits are not supported by cgroup v2 and ignored by recent kernels"},
+			{Name: "memory", Shorthand: "m", Field: "Memory", Type: "bytes", Default: "0", Usage: "Memory limit"},
+			{Name: "memory-reservation", Field: "MemoryReservation", Type: "bytes", Default: "0", Usage: "Memory soft limit"},
+			{Name: "memory-swap", Field: "MemorySwap", Type: "bytes", Default: "0", Usage: "Swap limit equal to memory plus swap: '-1' to enable unlimited swap"},
+			{Name: "pids-limit", Field: "PidsLimit", Type: "int64", Default: "0", Usage: "Tune container pids limit (set -1 for unlimited)", Annotations: Annotations{MinAPIVersion: "1.40"}},
+			{Name: "restart", Field: "Restart", Type: "string", Default: "", Usage: "Restart policy to apply when a container exits"},
+		},
+		option: func() interface{} { return &DockerUpdateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerUpdateCmd(*opt.(*DockerUpdateOption), args)
+		},
+	},
+	{
+		Name:  "DockerVersion",
+		Path:  []string{"docker", "version"},
+		Use:   "version [OPTIONS]",
+		Short: "Show the Docker version information",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+			{Name: "kubeconfig", Field: "Kubeconfig", Type: "string", Default: "", Usage: "Kubernetes config file", Deprecated: "Kubernetes stack and context support is deprecated", Annotations: Annotations{Kubernetes: true}},
+		},
+		option: func() interface{} { return &DockerVersionOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVersionCmd(*opt.(*DockerVersionOption), args)
+		},
+	},
+	{
+		Name:        "DockerVolume",
+		Path:        []string{"docker", "volume"},
+		Use:         "volume COMMAND",
+		Short:       "Manage volumes",
+		Annotations: Annotations{MinAPIVersion: "1.21"},
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerVolumeCmd(args)
+		},
+	},
+	{
+		Name:  "DockerVolumeCreate",
+		Path:  []string{"docker", "volume", "create"},
+		Use:   "create [OPTIONS] [VOLUME]",
+		Short: "Create a volume",
+		Flags: []FlagInfo{
+			{Name: "driver", Shorthand: "d", Field: "Driver", Type: "string", Default: "local", Usage: "Specify volume driver name"},
+			{Name: "label", Field: "Label", Type: "list", Default: "", Usage: "Set metadata for a volume"},
+			{Name: "name", Field: "Name", Type: "string", Default: "", Usage: "Specify volume name", Hidden: true},
+			{Name: "opt", Shorthand: "o", Field: "Opt", Type: "map", Default: "map[]", Usage: "Set driver specific options"},
+		},
+		option: func() interface{} { return &DockerVolumeCreateOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVolumeCreateCmd(*opt.(*DockerVolumeCreateOption), args)
+		},
+	},
+	{
+		Name:  "DockerVolumeInspect",
+		Path:  []string{"docker", "volume", "inspect"},
+		Use:   "inspect [OPTIONS] VOLUME [VOLUME...]",
+		Short: "Display detailed information on one or more volumes",
+		Flags: []FlagInfo{
+			{Name: "format", Shorthand: "f", Field: "Format", Type: "string", Default: "", Usage: "Format the output using the given Go template"},
+		},
+		option: func() interface{} { return &DockerVolumeInspectOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVolumeInspectCmd(*opt.(*DockerVolumeInspectOption), args)
+		},
+	},
+	{
+		Name:    "DockerVolumeLs",
+		Path:    []string{"docker", "volume", "ls"},
+		Aliases: []string{"list"},
+		Use:     "ls [OPTIONS]",
+		Short:   "List volumes",
+		Flags: []FlagInfo{
+			{Name: "filter", Shorthand: "f", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'dangling=true')"},
+			{Name: "format", Field: "Format", Type: "string", Default: "", Usage: "Pretty-print volumes using a Go template"},
+			{Name: "quiet", Shorthand: "q", Field: "Quiet", Type: "bool", Default: "false", Usage: "Only display volume names"},
+		},
+		option: func() interface{} { return &DockerVolumeLsOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVolumeLsCmd(*opt.(*DockerVolumeLsOption), args)
+		},
+	},
+	{
+		Name:        "DockerVolumePrune",
+		Path:        []string{"docker", "volume", "prune"},
+		Use:         "prune [OPTIONS]",
+		Short:       "Remove all unused local volumes",
+		Annotations: Annotations{MinAPIVersion: "1.25"},
+		Flags: []FlagInfo{
+			{Name: "filter", Field: "Filter", Type: "filter", Default: "", Usage: "Provide filter values (e.g. 'label=<label>')"},
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Do not prompt for confirmation"},
+		},
+		option: func() interface{} { return &DockerVolumePruneOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVolumePruneCmd(*opt.(*DockerVolumePruneOption), args)
+		},
+	},
+	{
+		Name:    "DockerVolumeRm",
+		Path:    []string{"docker", "volume", "rm"},
+		Aliases: []string{"remove"},
+		Use:     "rm [OPTIONS] VOLUME [VOLUME...]",
+		Short:   "Remove one or more volumes",
+		Flags: []FlagInfo{
+			{Name: "force", Shorthand: "f", Field: "Force", Type: "bool", Default: "false", Usage: "Force the removal of one or more volumes", Annotations: Annotations{MinAPIVersion: "1.25"}},
+		},
+		option: func() interface{} { return &DockerVolumeRmOption{} },
+		cmd: func(opt interface{}, args []string) *exec.Cmd {
+			return DockerVolumeRmCmd(*opt.(*DockerVolumeRmOption), args)
+		},
+	},
+	{
+		Name:  "DockerWait",
+		Path:  []string{"docker", "wait"},
+		Use:   "wait CONTAINER [CONTAINER...]",
+		Short: "Block until one or more containers stop, then print their exit codes",
+		cmd: func(_ interface{}, args []string) *exec.Cmd {
+			return DockerWaitCmd(args)
+		},
+	},
+}
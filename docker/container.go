@@ -0,0 +1,180 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Container is a handle to a container started by StartContainer.
+type Container struct {
+	ID    string
+	Name  string
+	Ports map[string][]HostBinding
+}
+
+// ContainerState is the subset of 'docker inspect' state used by the
+// lifecycle helpers.
+type ContainerState struct {
+	Status   string
+	Running  bool
+	ExitCode int
+	Health   string
+}
+
+type containerInspect struct {
+	ID    string `json:"Id"`
+	Name  string
+	State struct {
+		Status   string
+		Running  bool
+		ExitCode int
+		Health   *struct {
+			Status string
+		}
+	}
+	NetworkSettings struct {
+		Ports map[string][]struct {
+			HostIp   string
+			HostPort string
+		}
+	}
+}
+
+/*
+StartContainer runs a detached container with 'docker run' and blocks until
+every strategy reports it as ready. The Detach option is always set.
+
+When a strategy fails the container is left running and returned together
+with the error, so that the caller can inspect or remove it.
+*/
+func StartContainer(ctx context.Context, opt DockerRunOption, args []string, strategies ...WaitStrategy) (*Container, error) {
+	opt.Detach = ptr(true)
+	out, err := output(ctx, DockerRunCmd(opt, args))
+	if err != nil {
+		return nil, err
+	}
+
+	id := lastLine(out)
+	if id == "" {
+		return nil, errors.New("docker run did not print a container ID")
+	}
+
+	c := &Container{ID: id}
+	if err := c.Refresh(ctx); err != nil {
+		return c, err
+	}
+
+	for _, s := range strategies {
+		if err := s.WaitUntilReady(ctx, c); err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
+
+// Refresh reloads the name and published ports of the container.
+func (c *Container) Refresh(ctx context.Context) error {
+	info, err := c.inspect(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.Name = strings.TrimPrefix(info.Name, "/")
+	c.Ports = map[string][]HostBinding{}
+	for port, bindings := range info.NetworkSettings.Ports {
+		for _, b := range bindings {
+			n, err := strconv.Atoi(b.HostPort)
+			if err != nil {
+				continue
+			}
+			c.Ports[port] = append(c.Ports[port], newHostBinding(b.HostIp, n))
+		}
+	}
+
+	return nil
+}
+
+// State returns the current state of the container.
+func (c *Container) State(ctx context.Context) (ContainerState, error) {
+	info, err := c.inspect(ctx)
+	if err != nil {
+		return ContainerState{}, err
+	}
+
+	state := ContainerState{
+		Status:   info.State.Status,
+		Running:  info.State.Running,
+		ExitCode: info.State.ExitCode,
+	}
+	if info.State.Health != nil {
+		state.Health = info.State.Health.Status
+	}
+
+	return state, nil
+}
+
+// Logs returns the combined stdout and stderr logs of the container.
+func (c *Container) Logs(ctx context.Context) (string, error) {
+	cmd := withContext(ctx, DockerLogsCmd(DockerLogsOption{}, []string{c.ID}))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", cmdError(cmd, err, string(out))
+	}
+	return string(out), nil
+}
+
+// Remove force-removes the container together with its anonymous volumes.
+func (c *Container) Remove(ctx context.Context) error {
+	_, err := output(ctx, DockerRmCmd(DockerRmOption{
+		Force:   ptr(true),
+		Volumes: ptr(true),
+	}, []string{c.ID}))
+	return err
+}
+
+// HostPort returns the first host binding of port, e.g. "80/tcp". A port
+// without a protocol is treated as tcp. Use Address to connect to the port.
+func (c *Container) HostPort(port string) (HostBinding, error) {
+	port = normalizePort(port)
+	bindings := c.Ports[port]
+	if len(bindings) == 0 {
+		return HostBinding{}, fmt.Errorf("container %s: port %s is not published", c.ID, port)
+	}
+	return bindings[0], nil
+}
+
+// Address returns the local dial address of port, e.g. "80/tcp". A port
+// without a protocol is treated as tcp.
+func (c *Container) Address(port string) (string, error) {
+	port = normalizePort(port)
+	addr, err := LocalAddress(c.Ports[port])
+	if err != nil {
+		return "", fmt.Errorf("container %s: port %s is not published", c.ID, port)
+	}
+	return addr, nil
+}
+
+func (c *Container) inspect(ctx context.Context) (*containerInspect, error) {
+	out, err := output(ctx, DockerInspectCmd(DockerInspectOption{
+		Format: ptr("{{json .}}"),
+		Type:   ptr("container"),
+	}, []string{c.ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	var info containerInspect
+	if err := json.Unmarshal(out, &info); err != nil {
+		return nil, fmt.Errorf("decode inspect output: %w", err)
+	}
+
+	return &info, nil
+}
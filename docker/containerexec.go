@@ -0,0 +1,103 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// ExecIO holds the streams connected to a command run by Exec. Nil streams
+// are not attached.
+type ExecIO struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+/*
+Exec runs cmd inside container with 'docker exec' and returns the exit code
+of cmd. A non-zero exit code is not an error; the error is non-nil only when
+docker itself failed, and is then a *CmdError. That is the case when docker
+exits with 125, when the container is not running (and so cmd cannot have
+run), and when docker reports with 126 or 127 that cmd could not be started
+or was not found. Exit codes 126 and 127 of cmd itself are returned without
+an error.
+
+Env, EnvFile, User, Workdir and Privileged of opt are honoured. Interactive
+is set when stdio.Stdin is given, while Detach and Tty are ignored.
+*/
+func Exec(ctx context.Context, container string, opt DockerExecOption, cmd []string, stdio ExecIO) (int, error) {
+	opt.Detach = nil
+	opt.Tty = nil
+	if stdio.Stdin != nil {
+		opt.Interactive = ptr(true)
+	}
+
+	c := withContext(ctx, DockerExecCmd(opt, append([]string{container}, cmd...)))
+	c.Stdin = stdio.Stdin
+	c.Stdout = stdio.Stdout
+
+	tail := &tailBuffer{max: 4096}
+	if stdio.Stderr != nil {
+		c.Stderr = io.MultiWriter(stdio.Stderr, tail)
+	} else {
+		c.Stderr = tail
+	}
+
+	err := c.Run()
+	if err == nil {
+		return 0, nil
+	}
+	if ctx.Err() != nil {
+		return -1, ctx.Err()
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return -1, cmdError(c, err, tail.String())
+	}
+
+	code := exitErr.ExitCode()
+	if code == 125 || (code == 126 || code == 127) && strings.Contains(tail.String(), execStartFailed) {
+		return code, cmdError(c, err, tail.String())
+	}
+	running, rerr := containerRunning(ctx, container)
+	if rerr != nil || !running {
+		return code, cmdError(c, err, tail.String())
+	}
+	return code, nil
+}
+
+// execStartFailed is in the message docker prints when the runtime cannot
+// start the command of 'docker exec', exiting with 126 or 127.
+const execStartFailed = "OCI runtime exec failed"
+
+// containerRunning reports whether container exists and is running.
+func containerRunning(ctx context.Context, container string) (bool, error) {
+	cmd := DockerContainerInspectCmd(DockerContainerInspectOption{Format: ptr("{{.State.Running}}")}, []string{container})
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(out)) == "true", nil
+}
+
+// tailBuffer keeps the last max bytes written to it.
+type tailBuffer struct {
+	max int
+	buf []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.max {
+		b.buf = b.buf[len(b.buf)-b.max:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	return string(b.buf)
+}
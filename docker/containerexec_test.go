@@ -0,0 +1,54 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestExecExitCodes(t *testing.T) {
+	fakeDocker(t, `case "$1" in
+exec) [ -n "$EXEC_STDERR" ] && echo "$EXEC_STDERR" >&2; exit "$EXEC_CODE";;
+container) [ -n "$RUNNING" ] || { echo "Error: No such container: web" >&2; exit 1; }; echo "$RUNNING";;
+esac
+`)
+
+	tests := []struct {
+		name    string
+		code    string
+		stderr  string
+		running string
+		wantErr bool
+	}{
+		{name: "success", code: "0", running: "true"},
+		{name: "command failed", code: "3", running: "true"},
+		{name: "command exited 127", code: "127", stderr: "sh: frobnicate: not found", running: "true"},
+		{name: "command exited 126", code: "126", stderr: "sh: ./run: Permission denied", running: "true"},
+		{name: "docker failed", code: "125", running: "true", wantErr: true},
+		{
+			name:    "not found by the runtime",
+			code:    "127",
+			stderr:  `OCI runtime exec failed: exec failed: unable to start container process: exec: "frobnicate": executable file not found in $PATH: unknown`,
+			running: "true",
+			wantErr: true,
+		},
+		{name: "container stopped", code: "1", stderr: "Error response from daemon: container web is not running", running: "false", wantErr: true},
+		{name: "no container", code: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("EXEC_CODE", tt.code)
+		t.Setenv("EXEC_STDERR", tt.stderr)
+		t.Setenv("RUNNING", tt.running)
+
+		code, err := Exec(context.Background(), "web", DockerExecOption{}, []string{"frobnicate"}, ExecIO{})
+		if want, _ := strconv.Atoi(tt.code); code != want {
+			t.Errorf("%s: code = %d, want %d", tt.name, code, want)
+		}
+		var cmdErr *CmdError
+		if (err != nil) != tt.wantErr || err != nil && !errors.As(err, &cmdErr) {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
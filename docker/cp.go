@@ -0,0 +1,190 @@
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os/exec"
+	"path"
+)
+
+/*
+CopyToContainer extracts the tar stream r into dir inside container with
+'docker cp - CONTAINER:DIR'. The uid and gid recorded in the stream are
+only kept when Archive of opt is set; otherwise docker chowns the files to
+the container's root user. File modes are always preserved.
+*/
+func CopyToContainer(ctx context.Context, opt DockerCpOption, r io.Reader, container, dir string) error {
+	cmd := DockerCpCmd(opt, []string{"-", container + ":" + dir})
+	cmd.Stdin = r
+	_, err := output(ctx, cmd)
+	return err
+}
+
+// CopyFSToContainer copies the files of fsys into dir inside container,
+// with the same semantics as CopyToContainer.
+func CopyFSToContainer(ctx context.Context, opt DockerCpOption, fsys fs.FS, container, dir string) error {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeFSTar(pw, fsys))
+	}()
+
+	err := CopyToContainer(ctx, opt, pr, container, dir)
+	pr.Close()
+	return err
+}
+
+func writeFSTar(w io.Writer, fsys fs.FS) error {
+	tw := tar.NewWriter(w)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || name == "." {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if rl, ok := fsys.(interface{ ReadLink(string) (string, error) }); ok {
+				link, err = rl.ReadLink(name)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+/*
+CopyFromContainer returns the tar stream of path inside container, read
+with 'docker cp CONTAINER:PATH -'. The archive's entries are rooted at the
+base name of path. Closing the returned reader waits for docker to exit and
+reports its error.
+*/
+func CopyFromContainer(ctx context.Context, opt DockerCpOption, container, path string) (io.ReadCloser, error) {
+	c := withContext(ctx, DockerCpCmd(opt, []string{container + ":" + path, "-"}))
+	tail := &tailBuffer{max: 4096}
+	c.Stderr = tail
+
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Start(); err != nil {
+		return nil, cmdError(c, err, "")
+	}
+
+	return &cmdReader{ReadCloser: stdout, cmd: c, stderr: tail}, nil
+}
+
+type cmdReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *tailBuffer
+}
+
+func (r *cmdReader) Close() error {
+	// Drain the stream so that docker does not block on a full pipe.
+	io.Copy(io.Discard, r.ReadCloser)
+	if err := r.cmd.Wait(); err != nil {
+		return cmdError(r.cmd, err, r.stderr.String())
+	}
+	return nil
+}
+
+// abort stops docker without reading the rest of the stream.
+func (r *cmdReader) abort() {
+	r.cmd.Process.Kill()
+	r.cmd.Wait()
+}
+
+// WalkContainerFiles calls fn for every entry of the tar stream of path
+// inside container. The reader passed to fn is only valid during the call.
+func WalkContainerFiles(ctx context.Context, opt DockerCpOption, container, path string, fn func(hdr *tar.Header, r io.Reader) error) error {
+	r, err := CopyFromContainer(ctx, opt, container, path)
+	if err != nil {
+		return err
+	}
+	rc := r.(*cmdReader)
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if cerr := rc.Close(); cerr != nil {
+				return cerr
+			}
+			return err
+		}
+		if err := fn(hdr, tr); err != nil {
+			rc.abort()
+			return err
+		}
+	}
+
+	return rc.Close()
+}
+
+var errFound = errors.New("found")
+
+// ReadContainerFile returns the contents of the regular file at path
+// inside container.
+func ReadContainerFile(ctx context.Context, container, file string) ([]byte, error) {
+	var data []byte
+	err := WalkContainerFiles(ctx, DockerCpOption{FollowLink: ptr(true)}, container, file, func(hdr *tar.Header, r io.Reader) error {
+		if hdr.Typeflag != tar.TypeReg || hdr.Name != path.Base(file) {
+			return nil
+		}
+
+		var err error
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return errFound
+	})
+	if err == errFound {
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%s:%s is not a regular file", container, file)
+}
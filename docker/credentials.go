@@ -0,0 +1,204 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const dockerHubServer = "https://index.docker.io/v1/"
+
+// ErrCredentialsNotFound is returned when no credentials are stored for a
+// registry.
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+/*
+Login logs in to server with 'docker login --password-stdin', reading the
+password from password so that it never appears on the command line. The
+Password field of opt is ignored.
+*/
+func Login(ctx context.Context, opt DockerLoginOption, server string, password io.Reader) error {
+	opt.Password = nil
+	opt.PasswordStdin = ptr(true)
+
+	var args []string
+	if server != "" {
+		args = []string{server}
+	}
+
+	cmd := DockerLoginCmd(opt, args)
+	cmd.Stdin = password
+	_, err := output(ctx, cmd)
+	return err
+}
+
+// Credentials are the credentials stored for a registry. When Username is
+// "<token>", Secret is an identity token rather than a password.
+type Credentials struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// AuthConfig is an entry of the "auths" section of config.json.
+type AuthConfig struct {
+	Auth          string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+}
+
+// ConfigFile is the part of the docker CLI's config.json dealing with
+// registry credentials.
+type ConfigFile struct {
+	Auths       map[string]AuthConfig `json:"auths"`
+	CredsStore  string                `json:"credsStore,omitempty"`
+	CredHelpers map[string]string     `json:"credHelpers,omitempty"`
+}
+
+// ConfigDir returns the client configuration directory used by docker:
+// the Config option, $DOCKER_CONFIG, or ~/.docker.
+func ConfigDir(opt DockerOption) string {
+	if opt.Config != nil {
+		return *opt.Config
+	}
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return dir
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".docker")
+}
+
+// LoadConfigFile reads config.json from the directory selected by opt. A
+// missing file yields an empty configuration.
+func LoadConfigFile(opt DockerOption) (*ConfigFile, error) {
+	cfg := &ConfigFile{}
+
+	b, err := os.ReadFile(filepath.Join(ConfigDir(opt), "config.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("decode config.json: %w", err)
+	}
+	return cfg, nil
+}
+
+/*
+Credentials returns the credentials for server, which may be a registry
+host such as "ghcr.io" or a Docker Hub domain. A credential helper
+configured in credHelpers takes precedence over credsStore, which takes
+precedence over the auths section.
+*/
+func (c *ConfigFile) Credentials(ctx context.Context, server string) (Credentials, error) {
+	key := registryKey(server)
+
+	if helper, ok := c.CredHelpers[key]; ok {
+		return helperCredentials(ctx, helper, key)
+	}
+	if c.CredsStore != "" {
+		return helperCredentials(ctx, c.CredsStore, c.authKey(key))
+	}
+
+	for k, auth := range c.Auths {
+		if registryKey(k) != key {
+			continue
+		}
+
+		creds := Credentials{ServerURL: k, Username: auth.Username, Secret: auth.Password}
+		if auth.Auth != "" {
+			b, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return Credentials{}, fmt.Errorf("decode auth for %s: %w", k, err)
+			}
+			user, pass, _ := strings.Cut(string(b), ":")
+			creds.Username, creds.Secret = user, pass
+		}
+		if auth.IdentityToken != "" {
+			creds.Username, creds.Secret = "<token>", auth.IdentityToken
+		}
+		if creds.Secret == "" {
+			break
+		}
+		return creds, nil
+	}
+
+	return Credentials{}, fmt.Errorf("%s: %w", server, ErrCredentialsNotFound)
+}
+
+// IsLoggedIn reports whether credentials are stored for server.
+func (c *ConfigFile) IsLoggedIn(ctx context.Context, server string) (bool, error) {
+	_, err := c.Credentials(ctx, server)
+	if errors.Is(err, ErrCredentialsNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// authKey returns the server address under which docker stored the
+// credentials of the registry key, preferring the spelling in auths.
+func (c *ConfigFile) authKey(key string) string {
+	for k := range c.Auths {
+		if registryKey(k) == key {
+			return k
+		}
+	}
+	if key == "docker.io" {
+		return dockerHubServer
+	}
+	return key
+}
+
+func helperCredentials(ctx context.Context, helper, server string) (Credentials, error) {
+	if server == "docker.io" {
+		server = dockerHubServer
+	}
+
+	cmd := exec.CommandContext(ctx, "docker-credential-"+helper, "get")
+	cmd.Stdin = strings.NewReader(server)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stdout.String() + stderr.String())
+		if strings.Contains(msg, "credentials not found") {
+			return Credentials{}, fmt.Errorf("%s: %w", server, ErrCredentialsNotFound)
+		}
+		return Credentials{}, cmdError(cmd, err, msg)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return Credentials{}, fmt.Errorf("decode %s output: %w", cmd.Path, err)
+	}
+	return creds, nil
+}
+
+// registryKey reduces a server address to its host, mapping the Docker Hub
+// aliases to "docker.io".
+func registryKey(server string) string {
+	s := server
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+3:]
+	}
+	s, _, _ = strings.Cut(s, "/")
+
+	switch s {
+	case "", "docker.io", "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return s
+}
@@ -0,0 +1,109 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegistryKey(t *testing.T) {
+	tests := []struct {
+		server string
+		want   string
+	}{
+		{"", "docker.io"},
+		{"docker.io", "docker.io"},
+		{"https://index.docker.io/v1/", "docker.io"},
+		{"registry-1.docker.io", "docker.io"},
+		{"ghcr.io", "ghcr.io"},
+		{"https://ghcr.io/v2/", "ghcr.io"},
+		{"localhost:5000/path", "localhost:5000"},
+	}
+
+	for _, tt := range tests {
+		if got := registryKey(tt.server); got != tt.want {
+			t.Errorf("registryKey(%q) = %q, want %q", tt.server, got, tt.want)
+		}
+	}
+}
+
+// fakeCredentialHelper installs docker-credential-NAME on PATH, which
+// answers with the credentials of the server read from stdin.
+func fakeCredentialHelper(t *testing.T, name string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := "#!/bin/sh\nread server\n" +
+		"case $server in missing*) echo 'credentials not found in native keychain'; exit 1;; esac\n" +
+		"echo '{\"ServerURL\":\"'$server'\",\"Username\":\"" + name + "\",\"Secret\":\"s3cret\"}'\n"
+	if err := os.WriteFile(filepath.Join(dir, "docker-credential-"+name), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestConfigFileCredentials(t *testing.T) {
+	fakeCredentialHelper(t, "helper")
+	fakeCredentialHelper(t, "store")
+
+	cfg := &ConfigFile{
+		Auths: map[string]AuthConfig{
+			"https://index.docker.io/v1/": {Auth: "dXNlcjpwYXNz"},
+			"ghcr.io":                     {Username: "gh", Password: "token"},
+			"quay.io":                     {IdentityToken: "idt"},
+			"bad.io":                      {Auth: "!!!"},
+			"empty.io":                    {},
+		},
+		CredHelpers: map[string]string{"gcr.io": "helper"},
+	}
+
+	tests := []struct {
+		server  string
+		want    Credentials
+		wantErr error
+	}{
+		{server: "docker.io", want: Credentials{ServerURL: "https://index.docker.io/v1/", Username: "user", Secret: "pass"}},
+		{server: "https://ghcr.io", want: Credentials{ServerURL: "ghcr.io", Username: "gh", Secret: "token"}},
+		{server: "quay.io", want: Credentials{ServerURL: "quay.io", Username: "<token>", Secret: "idt"}},
+		{server: "gcr.io", want: Credentials{ServerURL: "gcr.io", Username: "helper", Secret: "s3cret"}},
+		{server: "empty.io", wantErr: ErrCredentialsNotFound},
+		{server: "other.io", wantErr: ErrCredentialsNotFound},
+	}
+	for _, tt := range tests {
+		got, err := cfg.Credentials(context.Background(), tt.server)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Credentials(%q) error = %v, want %v", tt.server, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Credentials(%q) = %+v, want %+v", tt.server, got, tt.want)
+		}
+	}
+	if _, err := cfg.Credentials(context.Background(), "bad.io"); err == nil {
+		t.Error("Credentials(bad.io) accepted an invalid auth field")
+	}
+
+	// credsStore takes precedence over auths, but not over credHelpers.
+	cfg.CredsStore = "store"
+	tests = []struct {
+		server  string
+		want    Credentials
+		wantErr error
+	}{
+		{server: "docker.io", want: Credentials{ServerURL: "https://index.docker.io/v1/", Username: "store", Secret: "s3cret"}},
+		{server: "ghcr.io", want: Credentials{ServerURL: "ghcr.io", Username: "store", Secret: "s3cret"}},
+		{server: "gcr.io", want: Credentials{ServerURL: "gcr.io", Username: "helper", Secret: "s3cret"}},
+		{server: "missing.io", wantErr: ErrCredentialsNotFound},
+	}
+	for _, tt := range tests {
+		got, err := cfg.Credentials(context.Background(), tt.server)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Credentials(%q) with credsStore error = %v, want %v", tt.server, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Credentials(%q) with credsStore = %+v, want %+v", tt.server, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+DiskUsage is the space used by the daemon as reported by `docker system df`.
+Sizes are in bytes, decoded from docker's output, which rounds them to
+three significant digits.
+
+The per-object details are only set by a verbose query, see GetDiskUsage.
+*/
+type DiskUsage struct {
+	Images     DiskUsageSummary
+	Containers DiskUsageSummary
+	Volumes    DiskUsageSummary
+	BuildCache DiskUsageSummary
+
+	ImageDetails      []ImageUsage
+	ContainerDetails  []ContainerUsage
+	VolumeDetails     []VolumeUsage
+	BuildCacheDetails []BuildCacheUsage
+}
+
+// DiskUsageSummary is a line of `docker system df`.
+type DiskUsageSummary struct {
+	TotalCount int
+
+	// Active counts the objects in use: images with containers, running,
+	// paused or restarting containers, volumes with containers and build
+	// cache records in use.
+	Active int
+
+	Size int64
+
+	// Reclaimable is the space freed by pruning the objects not in use.
+	Reclaimable int64
+}
+
+// ImageUsage is the space used by an image.
+type ImageUsage struct {
+	ID         string
+	Repository string
+	Tag        string
+	Digest     string
+	Created    time.Time
+
+	// Size is the size of the image including its parents; SharedSize is
+	// the part shared with other images and UniqueSize the rest. Shared and
+	// unique sizes are -1 if unknown.
+	Size       int64
+	SharedSize int64
+	UniqueSize int64
+
+	// Containers is the number of containers using the image, or -1 if
+	// unknown.
+	Containers int
+}
+
+// ContainerUsage is the space used by a container.
+type ContainerUsage struct {
+	ID      string
+	Names   string
+	Image   string
+	Command string
+	Created time.Time
+	State   string
+	Status  string
+
+	// Size is the size of the container's writable layer.
+	Size int64
+
+	// LocalVolumes is the number of volumes of the "local" driver the
+	// container mounts.
+	LocalVolumes int
+}
+
+// VolumeUsage is the space used by a volume.
+type VolumeUsage struct {
+	Name       string
+	Driver     string
+	Scope      string
+	Mountpoint string
+
+	// Links is the number of containers using the volume and Size its size;
+	// both are -1 if unknown.
+	Links int
+	Size  int64
+}
+
+// BuildCacheUsage is the space used by a build cache record.
+type BuildCacheUsage struct {
+	ID          string
+	Parent      string
+	CacheType   string
+	Description string
+	Created     time.Time
+
+	// LastUsed is zero if the record was never used.
+	LastUsed   time.Time
+	UsageCount int
+
+	InUse  bool
+	Shared bool
+	Size   int64
+}
+
+// GetDiskUsage runs `docker system df` with the global options opt. With
+// verbose it also runs `docker system df --verbose` for the per-object
+// details.
+func GetDiskUsage(ctx context.Context, opt DockerOption, verbose bool) (*DiskUsage, error) {
+	cmd := withGlobal(DockerSystemDfCmd(DockerSystemDfOption{Format: ptr("{{json .}}")}, nil), opt)
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	du, err := ParseDiskUsage(out)
+	if err != nil || !verbose {
+		return du, err
+	}
+
+	cmd = withGlobal(DockerSystemDfCmd(DockerSystemDfOption{Format: ptr("{{json .}}"), Verbose: ptr(true)}, nil), opt)
+	out, err = output(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if err := du.parseVerbose(out); err != nil {
+		return nil, err
+	}
+	return du, nil
+}
+
+// DiskUsage returns the space used by the client's daemon, see GetDiskUsage.
+func (c *Client) DiskUsage(ctx context.Context, verbose bool) (*DiskUsage, error) {
+	return GetDiskUsage(ctx, c.Global, verbose)
+}
+
+// ParseDiskUsage decodes the output of `docker system df --format '{{json .}}'`,
+// one object per line.
+func ParseDiskUsage(data []byte) (*DiskUsage, error) {
+	du := &DiskUsage{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var raw struct {
+			Type        string
+			TotalCount  string
+			Active      string
+			Size        string
+			Reclaimable string
+		}
+		if err := json.Unmarshal(line, &raw); err != nil {
+			return nil, err
+		}
+
+		var s *DiskUsageSummary
+		switch raw.Type {
+		case "Images":
+			s = &du.Images
+		case "Containers":
+			s = &du.Containers
+		case "Local Volumes":
+			s = &du.Volumes
+		case "Build Cache":
+			s = &du.BuildCache
+		default:
+			continue
+		}
+
+		// Reclaimable sizes are followed by a percentage, e.g. "1.2GB (50%)".
+		reclaimable, _, _ := strings.Cut(raw.Reclaimable, " (")
+		var err error
+		if s.TotalCount, err = strconv.Atoi(raw.TotalCount); err != nil {
+			return nil, fmt.Errorf("%s: invalid total count %q", raw.Type, raw.TotalCount)
+		}
+		if s.Active, err = strconv.Atoi(raw.Active); err != nil {
+			return nil, fmt.Errorf("%s: invalid active count %q", raw.Type, raw.Active)
+		}
+		if s.Size, err = parseSize(raw.Size); err != nil {
+			return nil, fmt.Errorf("%s: %w", raw.Type, err)
+		}
+		if s.Reclaimable, err = parseSize(reclaimable); err != nil {
+			return nil, fmt.Errorf("%s: %w", raw.Type, err)
+		}
+	}
+	return du, scanner.Err()
+}
+
+// ParseDiskUsageVerbose decodes the output of
+// `docker system df --verbose --format '{{json .}}'`. Only the details are
+// set; the summaries are computed from them as docker does, except for the
+// size of images, which is the sum of their unique sizes and so does not
+// include layers shared between images.
+func ParseDiskUsageVerbose(data []byte) (*DiskUsage, error) {
+	du := &DiskUsage{}
+	if err := du.parseVerbose(data); err != nil {
+		return nil, err
+	}
+	du.summarize()
+	return du, nil
+}
+
+func (du *DiskUsage) parseVerbose(data []byte) error {
+	var raw struct {
+		Images     []map[string]string
+		Containers []map[string]string
+		Volumes    []map[string]string
+		BuildCache []map[string]string
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	du.ImageDetails = make([]ImageUsage, len(raw.Images))
+	for i, m := range raw.Images {
+		f := usageFields{m: m}
+		du.ImageDetails[i] = ImageUsage{
+			ID:         m["ID"],
+			Repository: m["Repository"],
+			Tag:        m["Tag"],
+			Digest:     m["Digest"],
+			Created:    f.time("CreatedAt"),
+			Size:       f.size("VirtualSize"),
+			SharedSize: f.size("SharedSize"),
+			UniqueSize: f.size("UniqueSize"),
+			Containers: f.count("Containers"),
+		}
+		if f.err != nil {
+			return fmt.Errorf("image %s: %w", m["ID"], f.err)
+		}
+	}
+
+	du.ContainerDetails = make([]ContainerUsage, len(raw.Containers))
+	for i, m := range raw.Containers {
+		f := usageFields{m: m}
+		command, err := strconv.Unquote(m["Command"])
+		if err != nil {
+			command = m["Command"]
+		}
+		du.ContainerDetails[i] = ContainerUsage{
+			ID:           m["ID"],
+			Names:        m["Names"],
+			Image:        m["Image"],
+			Command:      command,
+			Created:      f.time("CreatedAt"),
+			State:        m["State"],
+			Status:       m["Status"],
+			Size:         f.size("Size"),
+			LocalVolumes: f.count("LocalVolumes"),
+		}
+		if f.err != nil {
+			return fmt.Errorf("container %s: %w", m["ID"], f.err)
+		}
+	}
+
+	du.VolumeDetails = make([]VolumeUsage, len(raw.Volumes))
+	for i, m := range raw.Volumes {
+		f := usageFields{m: m}
+		du.VolumeDetails[i] = VolumeUsage{
+			Name:       m["Name"],
+			Driver:     m["Driver"],
+			Scope:      m["Scope"],
+			Mountpoint: m["Mountpoint"],
+			Links:      f.count("Links"),
+			Size:       f.size("Size"),
+		}
+		if f.err != nil {
+			return fmt.Errorf("volume %s: %w", m["Name"], f.err)
+		}
+	}
+
+	du.BuildCacheDetails = make([]BuildCacheUsage, len(raw.BuildCache))
+	for i, m := range raw.BuildCache {
+		f := usageFields{m: m}
+		du.BuildCacheDetails[i] = BuildCacheUsage{
+			// In-use records are marked with a "*".
+			ID:          strings.TrimSuffix(m["ID"], "*"),
+			Parent:      m["Parent"],
+			CacheType:   m["CacheType"],
+			Description: m["Description"],
+			Created:     f.time("CreatedAt"),
+			LastUsed:    f.time("LastUsedAt"),
+			UsageCount:  f.count("UsageCount"),
+			InUse:       m["InUse"] == "true",
+			Shared:      m["Shared"] == "true",
+			Size:        f.size("Size"),
+		}
+		if f.err != nil {
+			return fmt.Errorf("build cache %s: %w", m["ID"], f.err)
+		}
+	}
+
+	return nil
+}
+
+// summarize computes the summaries from the details.
+func (du *DiskUsage) summarize() {
+	du.Images = DiskUsageSummary{TotalCount: len(du.ImageDetails)}
+	for _, img := range du.ImageDetails {
+		if img.UniqueSize > 0 {
+			du.Images.Size += img.UniqueSize
+		}
+		if img.Containers > 0 {
+			du.Images.Active++
+		} else if img.UniqueSize > 0 {
+			du.Images.Reclaimable += img.UniqueSize
+		}
+	}
+
+	du.Containers = DiskUsageSummary{TotalCount: len(du.ContainerDetails)}
+	for _, c := range du.ContainerDetails {
+		du.Containers.Size += c.Size
+		if c.Active() {
+			du.Containers.Active++
+		} else {
+			du.Containers.Reclaimable += c.Size
+		}
+	}
+
+	du.Volumes = DiskUsageSummary{TotalCount: len(du.VolumeDetails)}
+	for _, v := range du.VolumeDetails {
+		if v.Links > 0 {
+			du.Volumes.Active++
+		}
+		if v.Size < 0 {
+			continue
+		}
+		du.Volumes.Size += v.Size
+		if v.Links == 0 {
+			du.Volumes.Reclaimable += v.Size
+		}
+	}
+
+	du.BuildCache = DiskUsageSummary{TotalCount: len(du.BuildCacheDetails)}
+	for _, bc := range du.BuildCacheDetails {
+		if bc.InUse {
+			du.BuildCache.Active++
+		}
+		if !bc.Shared {
+			du.BuildCache.Size += bc.Size
+			if !bc.InUse {
+				du.BuildCache.Reclaimable += bc.Size
+			}
+		}
+	}
+}
+
+// Size returns the total space used.
+func (du *DiskUsage) Size() int64 {
+	return du.Images.Size + du.Containers.Size + du.Volumes.Size + du.BuildCache.Size
+}
+
+// Reclaimable returns the total space freed by pruning everything not in
+// use.
+func (du *DiskUsage) Reclaimable() int64 {
+	return du.Images.Reclaimable + du.Containers.Reclaimable + du.Volumes.Reclaimable + du.BuildCache.Reclaimable
+}
+
+// Active reports whether the container is running, paused or restarting,
+// which keeps its writable layer from being pruned.
+func (c ContainerUsage) Active() bool {
+	return strings.Contains(c.State, "running") ||
+		strings.Contains(c.State, "paused") ||
+		strings.Contains(c.State, "restarting")
+}
+
+// usageFields decodes the string fields docker's formatter prints, keeping
+// the first error.
+type usageFields struct {
+	m   map[string]string
+	err error
+}
+
+func (f *usageFields) size(key string) int64 {
+	s := f.m[key]
+	if s == "" || s == "N/A" {
+		return -1
+	}
+	// Container sizes may be followed by the virtual size, e.g.
+	// "2B (virtual 5.6MB)".
+	s, _, _ = strings.Cut(s, " (")
+	n, err := parseSize(s)
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("%s: %w", key, err)
+	}
+	return n
+}
+
+func (f *usageFields) count(key string) int {
+	s := f.m[key]
+	if s == "" || s == "N/A" {
+		return -1
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("%s: invalid count %q", key, s)
+	}
+	return n
+}
+
+// time parses times as printed by Go's time.Time.String, e.g.
+// "2022-06-06 22:21:26 +0000 UTC".
+func (f *usageFields) time(key string) time.Time {
+	s := f.m[key]
+	if s == "" {
+		return time.Time{}
+	}
+	// Drop the monotonic clock reading, if any.
+	s, _, _ = strings.Cut(s, " m=")
+	t, err := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", s)
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("%s: invalid time %q", key, s)
+	}
+	return t
+}
@@ -0,0 +1,109 @@
+package docker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDiskUsage(t *testing.T) {
+	out := `{"Active":"1","Reclaimable":"30MB (50%)","Size":"60MB","TotalCount":"4","Type":"Images"}
+{"Active":"1","Reclaimable":"2kB (50%)","Size":"4kB","TotalCount":"2","Type":"Containers"}
+{"Active":"0","Reclaimable":"0B","Size":"0B","TotalCount":"0","Type":"Local Volumes"}
+{"Active":"0","Reclaimable":"1.5MB","Size":"1.5MB","TotalCount":"1","Type":"Build Cache"}
+`
+	du, err := ParseDiskUsage([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  DiskUsageSummary
+		want DiskUsageSummary
+	}{
+		{"images", du.Images, DiskUsageSummary{TotalCount: 4, Active: 1, Size: 60e6, Reclaimable: 30e6}},
+		{"containers", du.Containers, DiskUsageSummary{TotalCount: 2, Active: 1, Size: 4e3, Reclaimable: 2e3}},
+		{"volumes", du.Volumes, DiskUsageSummary{}},
+		{"build cache", du.BuildCache, DiskUsageSummary{TotalCount: 1, Size: 1.5e6, Reclaimable: 1.5e6}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %+v, want %+v", tt.name, tt.got, tt.want)
+		}
+	}
+	if du.Size() != 60e6+4e3+1.5e6 || du.Reclaimable() != 30e6+2e3+1.5e6 {
+		t.Errorf("Size, Reclaimable = %d, %d", du.Size(), du.Reclaimable())
+	}
+
+	for _, bad := range []string{
+		`{"Type":"Images","TotalCount":"x","Active":"0","Size":"0B","Reclaimable":"0B"}`,
+		`{"Type":"Images","TotalCount":"1","Active":"0","Size":"lots","Reclaimable":"0B"}`,
+		`not json`,
+	} {
+		if _, err := ParseDiskUsage([]byte(bad)); err == nil {
+			t.Errorf("ParseDiskUsage(%s) succeeded", bad)
+		}
+	}
+}
+
+func TestParseDiskUsageVerbose(t *testing.T) {
+	out := `{"Images":[
+{"Containers":"1","CreatedAt":"2022-05-23 19:19:31 +0000 UTC","Digest":"<none>","ID":"sha256:a3","Repository":"app","SharedSize":"5MB","Tag":"v3","UniqueSize":"10MB","VirtualSize":"15MB"},
+{"Containers":"0","CreatedAt":"2022-05-22 19:19:31 +0000 UTC","Digest":"<none>","ID":"sha256:a2","Repository":"app","SharedSize":"N/A","Tag":"v2","UniqueSize":"N/A","VirtualSize":"20MB"},
+{"Containers":"0","CreatedAt":"2022-05-21 19:19:31 +0000 UTC","Digest":"<none>","ID":"sha256:a1","Repository":"app","SharedSize":"0B","Tag":"v1","UniqueSize":"25MB","VirtualSize":"25MB"}],
+"Containers":[
+{"Command":"\"sh -c 'sleep 1'\"","CreatedAt":"2022-06-01 10:00:00 +0000 UTC","ID":"c1","Image":"app:v3","LocalVolumes":"1","Names":"run","Size":"2kB (virtual 15MB)","State":"running","Status":"Up"},
+{"Command":"\"sh\"","CreatedAt":"2022-06-01 10:00:00 +0000 UTC","ID":"c2","Image":"app:v1","LocalVolumes":"0","Names":"old","Size":"3kB","State":"exited","Status":"Exited (0)"}],
+"Volumes":[
+{"Driver":"local","Links":"1","Mountpoint":"/x","Name":"v1","Scope":"local","Size":"10kB"},
+{"Driver":"local","Links":"0","Mountpoint":"/y","Name":"v2","Scope":"local","Size":"20kB"},
+{"Driver":"nfs","Links":"N/A","Mountpoint":"","Name":"v3","Scope":"global","Size":"N/A"}],
+"BuildCache":[
+{"CacheType":"regular","CreatedAt":"2022-06-01 10:00:00.123456 +0000 UTC m=+0.5","Description":"d","ID":"b1*","InUse":"true","LastUsedAt":"","Parent":"","Shared":"false","Size":"1MB","UsageCount":"3"},
+{"CacheType":"regular","CreatedAt":"2022-06-01 10:00:00 +0000 UTC","Description":"d","ID":"b2","InUse":"false","LastUsedAt":"2022-06-02 10:00:00 +0000 UTC","Parent":"b1","Shared":"true","Size":"2MB","UsageCount":"1"},
+{"CacheType":"regular","CreatedAt":"2022-06-01 10:00:00 +0000 UTC","Description":"d","ID":"b3","InUse":"false","LastUsedAt":"","Parent":"","Shared":"false","Size":"4MB","UsageCount":"0"}]}`
+
+	du, err := ParseDiskUsageVerbose([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if img := du.ImageDetails[1]; img.UniqueSize != -1 || img.SharedSize != -1 || img.Size != 20e6 || img.Containers != 0 {
+		t.Errorf("image a2 = %+v", img)
+	}
+	if want := time.Date(2022, 5, 23, 19, 19, 31, 0, time.UTC); !du.ImageDetails[0].Created.Equal(want) {
+		t.Errorf("image a3 created = %v, want %v", du.ImageDetails[0].Created, want)
+	}
+	if c := du.ContainerDetails[0]; c.Command != "sh -c 'sleep 1'" || c.Size != 2e3 || !c.Active() || c.LocalVolumes != 1 {
+		t.Errorf("container c1 = %+v", c)
+	}
+	if du.ContainerDetails[1].Active() {
+		t.Error("exited container c2 is active")
+	}
+	if v := du.VolumeDetails[2]; v.Links != -1 || v.Size != -1 {
+		t.Errorf("volume v3 = %+v", v)
+	}
+	if bc := du.BuildCacheDetails[0]; bc.ID != "b1" || !bc.InUse || !bc.LastUsed.IsZero() || bc.Created.Nanosecond() != 123456000 {
+		t.Errorf("build cache b1 = %+v", bc)
+	}
+
+	tests := []struct {
+		name string
+		got  DiskUsageSummary
+		want DiskUsageSummary
+	}{
+		{"images", du.Images, DiskUsageSummary{TotalCount: 3, Active: 1, Size: 35e6, Reclaimable: 25e6}},
+		{"containers", du.Containers, DiskUsageSummary{TotalCount: 2, Active: 1, Size: 5e3, Reclaimable: 3e3}},
+		{"volumes", du.Volumes, DiskUsageSummary{TotalCount: 3, Active: 1, Size: 30e3, Reclaimable: 20e3}},
+		{"build cache", du.BuildCache, DiskUsageSummary{TotalCount: 3, Active: 1, Size: 5e6, Reclaimable: 4e6}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %+v, want %+v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if _, err := ParseDiskUsageVerbose([]byte(`{"Volumes":[{"Name":"v","Links":"many"}]}`)); err == nil {
+		t.Error("ParseDiskUsageVerbose accepted an invalid count")
+	}
+}
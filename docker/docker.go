@@ -308,6 +308,8 @@ type DockerBuildOption struct {
 
 	/*
 		Stream attaches to server to negotiate build context
+
+		Deprecated: the experimental --stream flag was removed in docker 20.10
 	*/
 	Stream *bool
 
@@ -628,6 +630,8 @@ type DockerBuilderBuildOption struct {
 
 	/*
 		Stream attaches to server to negotiate build context
+
+		Deprecated: the experimental --stream flag was removed in docker 20.10
 	*/
 	Stream *bool
 
@@ -1497,6 +1501,8 @@ type DockerContainerCreateOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -2882,6 +2888,8 @@ type DockerContainerRunOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -3746,6 +3754,8 @@ type DockerContainerUpdateOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -3862,6 +3872,8 @@ func DockerContextCmd(args []string) *exec.Cmd {
 type DockerContextCreateOption struct {
 	/*
 		Default orchestrator for stack operations to use with this context (swarm|kubernetes|all)
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	DefaultStackOrchestrator *string
 
@@ -3882,6 +3894,8 @@ type DockerContextCreateOption struct {
 
 	/*
 		set the kubernetes endpoint
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Kubernetes *string
 }
@@ -3917,6 +3931,8 @@ func DockerContextCreateCmd(opt DockerContextCreateOption, args []string) *exec.
 type DockerContextExportOption struct {
 	/*
 		Export as a kubeconfig file
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Kubeconfig *bool
 }
@@ -4030,6 +4046,8 @@ func DockerContextRmCmd(opt DockerContextRmOption, args []string) *exec.Cmd {
 type DockerContextUpdateOption struct {
 	/*
 		Default orchestrator for stack operations to use with this context (swarm|kubernetes|all)
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	DefaultStackOrchestrator *string
 
@@ -4045,6 +4063,8 @@ type DockerContextUpdateOption struct {
 
 	/*
 		set the kubernetes endpoint
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Kubernetes *string
 }
@@ -4388,6 +4408,8 @@ type DockerCreateOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -5433,6 +5455,8 @@ type DockerImageBuildOption struct {
 
 	/*
 		Stream attaches to server to negotiate build context
+
+		Deprecated: the experimental --stream flag was removed in docker 20.10
 	*/
 	Stream *bool
 
@@ -7980,6 +8004,8 @@ type DockerRunOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -10619,6 +10645,8 @@ type DockerStackDeployOption struct {
 
 	/*
 		Kubernetes namespace to use
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Namespace *string
 
@@ -10669,6 +10697,8 @@ func DockerStackDeployCmd(opt DockerStackDeployOption, args []string) *exec.Cmd
 type DockerStackLsOption struct {
 	/*
 		List stacks from all Kubernetes namespaces
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	AllNamespaces *bool
 
@@ -10679,6 +10709,8 @@ type DockerStackLsOption struct {
 
 	/*
 		Kubernetes namespaces to use
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Namespace *string
 }
@@ -10718,6 +10750,8 @@ type DockerStackPsOption struct {
 
 	/*
 		Kubernetes namespace to use
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Namespace *string
 
@@ -10771,6 +10805,8 @@ func DockerStackPsCmd(opt DockerStackPsOption, args []string) *exec.Cmd {
 type DockerStackRmOption struct {
 	/*
 		Kubernetes namespace to use
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Namespace *string
 }
@@ -10804,6 +10840,8 @@ type DockerStackServicesOption struct {
 
 	/*
 		Kubernetes namespace to use
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Namespace *string
 
@@ -11870,6 +11908,8 @@ type DockerUpdateOption struct {
 
 	/*
 		Kernel memory limit
+
+		Deprecated: kernel memory limits are not supported by cgroup v2 and ignored by recent kernels
 	*/
 	KernelMemory *string
 
@@ -11965,6 +12005,8 @@ type DockerVersionOption struct {
 
 	/*
 		Kubernetes config file
+
+		Deprecated: Kubernetes stack and context support is deprecated
 	*/
 	Kubeconfig *string
 }
@@ -0,0 +1,114 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CmdError is returned when a docker command exits unsuccessfully.
+type CmdError struct {
+	Args     []string
+	ExitCode int
+	Stderr   string
+	Err      error
+}
+
+func (e *CmdError) Error() string {
+	msg := strings.TrimSpace(e.Stderr)
+	if msg == "" {
+		msg = e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(RedactArgs(e.Args), " "), msg)
+}
+
+func (e *CmdError) Unwrap() error {
+	return e.Err
+}
+
+// withContext returns a copy of cmd that is killed when ctx is done. Every
+// command of the package is run through it, and recorded here if ctx
+// carries a Recorder.
+func withContext(ctx context.Context, cmd *exec.Cmd) *exec.Cmd {
+	if r := recorderFrom(ctx); r != nil {
+		r.Record(cmd)
+	}
+	c := exec.CommandContext(ctx, cmd.Args[0], cmd.Args[1:]...)
+	c.Env = cmd.Env
+	c.Dir = cmd.Dir
+	c.Stdin = cmd.Stdin
+	c.Stdout = cmd.Stdout
+	c.Stderr = cmd.Stderr
+	return c
+}
+
+// output runs cmd and returns its standard output. Failures are reported
+// as *CmdError carrying the captured standard error.
+func output(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	out, err := partialOutput(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// partialOutput is output, but also returns the standard output of failed
+// commands, such as the objects a `docker rm` removed before failing on
+// another.
+func partialOutput(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	c := withContext(ctx, cmd)
+	var stdout, stderr bytes.Buffer
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		if ctx.Err() != nil {
+			return stdout.Bytes(), ctx.Err()
+		}
+		return stdout.Bytes(), cmdError(c, err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+func cmdError(cmd *exec.Cmd, err error, stderr string) *CmdError {
+	code := -1
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		code = exitErr.ExitCode()
+	}
+	return &CmdError{
+		Args:     cmd.Args,
+		ExitCode: code,
+		Stderr:   stderr,
+		Err:      err,
+	}
+}
+
+// joinErrors combines the non-nil errors of errs into one error.
+func joinErrors(errs []error) error {
+	var msgs []string
+	var first error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if first == nil {
+			first = err
+		}
+		msgs = append(msgs, err.Error())
+	}
+
+	switch len(msgs) {
+	case 0:
+		return nil
+	case 1:
+		return first
+	default:
+		return errors.New(strings.Join(msgs, "; "))
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
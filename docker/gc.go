@@ -0,0 +1,516 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GCKind is a kind of object removed by garbage collection.
+type GCKind string
+
+const (
+	GCContainers GCKind = "containers"
+	GCImages     GCKind = "images"
+	GCBuildCache GCKind = "build cache"
+	GCVolumes    GCKind = "volumes"
+	GCNetworks   GCKind = "networks"
+)
+
+/*
+GCPolicy decides what garbage collection removes. Objects in use are never
+removed: running, paused and restarting containers, the images of the
+containers that remain, volumes mounted by containers and build cache
+records in use.
+*/
+type GCPolicy struct {
+	// Kinds are the kinds of objects to collect. If nil, containers,
+	// images, build cache and networks are collected; volumes hold data
+	// and are only collected when listed.
+	Kinds []GCKind
+
+	// KeepTags is the number of tags kept per repository, those of the
+	// newest images. An image is only removed once all its tags are beyond
+	// it; with zero, tagged images are kept.
+	KeepTags int
+
+	// KeepRecent keeps the objects used within the period. Docker does not
+	// record when an image was last used, so images count as used when they
+	// were created within the period or a remaining container uses them.
+	// Containers and networks count as used when created, and build cache
+	// records when last used. Volumes are not affected.
+	KeepRecent time.Duration
+
+	// ProtectLabels protects the containers, images, volumes and networks
+	// with any of the labels, given as "key" or "key=value".
+	ProtectLabels []string
+
+	// TargetFree is the free space in bytes to reach: collection stops once
+	// the plan is expected to reach it. With zero, everything the policy
+	// allows is collected. FreeSpace reports the free space of the
+	// filesystem holding the daemon's data and is required with TargetFree.
+	TargetFree int64
+	FreeSpace  func(ctx context.Context) (int64, error)
+}
+
+// GCPlan is the result of PlanGC: the commands garbage collection runs and
+// what they are expected to remove. Planning removes nothing, so the plan
+// doubles as a dry run.
+type GCPlan struct {
+	Steps []GCStep
+
+	// Reclaim is the space the steps are expected to reclaim, in bytes.
+	Reclaim int64
+
+	// Need is the space to reclaim to reach the policy's TargetFree. It is
+	// zero without a target, or if the target is already reached.
+	Need int64
+}
+
+/*
+GCStep is a command of a GCPlan. Containers, images and volumes are removed
+by ID or name with `docker rm`, `docker image rm` and `docker volume rm`, so
+the step removes its Targets and nothing else; docker refuses to remove
+those that came into use since the plan was made. Build cache and networks
+cannot be removed by ID and are pruned, filtered by the policy's KeepRecent
+and ProtectLabels: their Targets are an estimate of what the prune removes.
+
+Expected sizes come from `docker system df`. For images they are the sizes
+not shared with other images, so removing images that share layers can
+reclaim more than expected.
+*/
+type GCStep struct {
+	Kind GCKind
+
+	// Targets are the objects the step is expected to remove: container
+	// and image IDs, image references, build cache IDs or volume names.
+	// Networks are not listed.
+	Targets []string
+
+	// Reclaim is the space the step is expected to reclaim, in bytes.
+	Reclaim int64
+
+	Cmd *exec.Cmd
+}
+
+// GCResult is the outcome of a step of a GCPlan.
+type GCResult struct {
+	Step GCStep
+
+	// Output is what docker printed: the removed objects and, for prune
+	// commands, the space reclaimed.
+	Output string
+
+	// Report is parsed from Output, or nil if it could not be. Only the
+	// prune commands print the space reclaimed; the other steps report
+	// what they removed without it.
+	Report *PruneReport
+
+	Err error
+}
+
+// PlanGC plans a garbage collection of the daemon selected by the global
+// options opt according to policy.
+func PlanGC(ctx context.Context, opt DockerOption, policy GCPolicy) (*GCPlan, error) {
+	p := &gcPlanner{ctx: ctx, opt: opt, policy: policy, plan: &GCPlan{}}
+	if policy.TargetFree > 0 {
+		if policy.FreeSpace == nil {
+			return nil, errors.New("GC policy with TargetFree needs FreeSpace")
+		}
+		free, err := policy.FreeSpace(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if free >= policy.TargetFree {
+			return p.plan, nil
+		}
+		p.plan.Need = policy.TargetFree - free
+	}
+
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+	if p.collects(GCContainers) {
+		p.containers()
+	}
+	if err := p.loadImages(); err != nil {
+		return nil, err
+	}
+	if p.collects(GCImages) {
+		p.danglingImages()
+	}
+	if p.collects(GCBuildCache) {
+		p.buildCache()
+	}
+	if p.collects(GCImages) {
+		p.taggedImages()
+	}
+	if p.collects(GCVolumes) {
+		p.volumes()
+	}
+	if p.collects(GCNetworks) {
+		p.networks()
+	}
+	return p.plan, nil
+}
+
+// report parses the output of the step's command. `docker rm` and
+// `docker volume rm` print the removed containers and volumes one per line.
+func (s GCStep) report(out []byte) *PruneReport {
+	var lines []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	switch s.Kind {
+	case GCContainers:
+		return &PruneReport{Containers: lines}
+	case GCVolumes:
+		return &PruneReport{Volumes: lines}
+	}
+	report, _ := ParsePruneReport(out)
+	return report
+}
+
+// PlanGC plans a garbage collection of the client's daemon, see PlanGC.
+func (c *Client) PlanGC(ctx context.Context, policy GCPolicy) (*GCPlan, error) {
+	return PlanGC(ctx, c.Global, policy)
+}
+
+// Execute runs the steps of the plan in order and returns their results. A
+// failed step does not stop the others; the errors are returned combined.
+// The reports of the results add up to what was removed, see
+// PruneReport.Add.
+func (p *GCPlan) Execute(ctx context.Context) ([]GCResult, error) {
+	var results []GCResult
+	var errs []error
+	for _, step := range p.Steps {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		out, err := partialOutput(ctx, step.Cmd)
+		results = append(results, GCResult{Step: step, Output: string(out), Report: step.report(out), Err: err})
+		errs = append(errs, err)
+	}
+	return results, joinErrors(errs)
+}
+
+type gcPlanner struct {
+	ctx    context.Context
+	opt    DockerOption
+	policy GCPolicy
+	plan   *GCPlan
+
+	du *DiskUsage
+
+	// protected holds the container and image IDs and volume names with
+	// protected labels.
+	protected map[string]bool
+
+	// removed holds the IDs of the containers planned for removal.
+	removed map[string]bool
+
+	// inUse holds the IDs of the images of the remaining containers.
+	inUse map[string]bool
+
+	// refs maps image IDs to their references, and repos repositories to
+	// the IDs of their tagged images.
+	refs  map[string][]string
+	repos map[string][]string
+
+	images map[string]ImageUsage
+}
+
+func (p *gcPlanner) collects(kind GCKind) bool {
+	if p.policy.Kinds == nil {
+		return kind != GCVolumes
+	}
+	for _, k := range p.policy.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *gcPlanner) load() error {
+	du, err := GetDiskUsage(p.ctx, p.opt, true)
+	if err != nil {
+		return err
+	}
+	p.du = du
+
+	p.images = map[string]ImageUsage{}
+	for _, img := range du.ImageDetails {
+		p.images[img.ID] = img
+	}
+
+	p.protected = map[string]bool{}
+	for _, label := range p.policy.ProtectLabels {
+		filter := []string{"--filter=label=" + label}
+		for _, cmd := range []*exec.Cmd{
+			DockerPsCmd(DockerPsOption{All: ptr(true), Quiet: ptr(true), NoTrunc: ptr(true)}, filter),
+			DockerImageLsCmd(DockerImageLsOption{Quiet: ptr(true), NoTrunc: ptr(true)}, filter),
+			DockerVolumeLsCmd(DockerVolumeLsOption{Quiet: ptr(true)}, filter),
+		} {
+			ids, err := listIDs(p.ctx, withGlobal(cmd, p.opt))
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				p.protected[id] = true
+			}
+		}
+	}
+	return nil
+}
+
+// loadImages finds the images of the containers that remain after the
+// containers step, and the references of every image.
+func (p *gcPlanner) loadImages() error {
+	var remaining []string
+	for _, c := range p.du.ContainerDetails {
+		if !p.removed[c.ID] {
+			remaining = append(remaining, c.ID)
+		}
+	}
+
+	p.inUse = map[string]bool{}
+	if len(remaining) > 0 {
+		cmd := DockerContainerInspectCmd(DockerContainerInspectOption{Format: ptr("{{.Image}}")}, remaining)
+		ids, err := listIDs(p.ctx, withGlobal(cmd, p.opt))
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			p.inUse[id] = true
+		}
+	}
+
+	cmd := DockerImageLsCmd(DockerImageLsOption{NoTrunc: ptr(true), Format: ptr("{{json .}}")}, nil)
+	out, err := output(p.ctx, withGlobal(cmd, p.opt))
+	if err != nil {
+		return err
+	}
+
+	p.refs = map[string][]string{}
+	p.repos = map[string][]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var row struct {
+			ID         string
+			Repository string
+			Tag        string
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			return err
+		}
+		if row.Repository == "<none>" || row.Tag == "<none>" {
+			continue
+		}
+		p.refs[row.ID] = append(p.refs[row.ID], row.Repository+":"+row.Tag)
+		p.repos[row.Repository] = append(p.repos[row.Repository], row.ID)
+	}
+	return scanner.Err()
+}
+
+func (p *gcPlanner) containers() {
+	p.removed = map[string]bool{}
+	if p.done() {
+		return
+	}
+
+	step := GCStep{Kind: GCContainers}
+	for _, c := range p.du.ContainerDetails {
+		if c.Active() || p.protected[c.ID] || p.recent(c.Created) {
+			continue
+		}
+		p.removed[c.ID] = true
+		step.Targets = append(step.Targets, c.ID)
+		step.Reclaim += c.Size
+	}
+	step.Cmd = DockerRmCmd(DockerRmOption{}, step.Targets)
+	p.add(step)
+}
+
+func (p *gcPlanner) danglingImages() {
+	if p.done() {
+		return
+	}
+
+	step := GCStep{Kind: GCImages}
+	for _, img := range p.du.ImageDetails {
+		if len(p.refs[img.ID]) > 0 || !p.removable(img) {
+			continue
+		}
+		step.Targets = append(step.Targets, img.ID)
+		step.Reclaim += positive(img.UniqueSize)
+	}
+	step.Cmd = DockerImageRmCmd(DockerImageRmOption{}, step.Targets)
+	p.add(step)
+}
+
+// taggedImages removes the images whose tags are all beyond the tags kept,
+// oldest first, until the target is reached.
+func (p *gcPlanner) taggedImages() {
+	if p.policy.KeepTags <= 0 || p.done() {
+		return
+	}
+
+	expendable := map[string]int{}
+	for _, ids := range p.repos {
+		ids = append([]string(nil), ids...)
+		sort.SliceStable(ids, func(i, j int) bool {
+			return p.images[ids[i]].Created.After(p.images[ids[j]].Created)
+		})
+		if len(ids) > p.policy.KeepTags {
+			for _, id := range ids[p.policy.KeepTags:] {
+				expendable[id]++
+			}
+		}
+	}
+
+	var candidates []ImageUsage
+	for id, n := range expendable {
+		img, ok := p.images[id]
+		if ok && n == len(p.refs[id]) && p.removable(img) {
+			candidates = append(candidates, img)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].Created.Equal(candidates[j].Created) {
+			return candidates[i].Created.Before(candidates[j].Created)
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	step := GCStep{Kind: GCImages}
+	for _, img := range candidates {
+		if p.plan.Need > 0 && p.plan.Reclaim+step.Reclaim >= p.plan.Need {
+			break
+		}
+		step.Targets = append(step.Targets, p.refs[img.ID]...)
+		step.Reclaim += positive(img.UniqueSize)
+	}
+	step.Cmd = DockerImageRmCmd(DockerImageRmOption{}, step.Targets)
+	p.add(step)
+}
+
+func (p *gcPlanner) buildCache() {
+	if p.done() {
+		return
+	}
+
+	step := GCStep{Kind: GCBuildCache}
+	for _, bc := range p.du.BuildCacheDetails {
+		lastUsed := bc.LastUsed
+		if lastUsed.IsZero() {
+			lastUsed = bc.Created
+		}
+		if bc.InUse || p.recent(lastUsed) {
+			continue
+		}
+		step.Targets = append(step.Targets, bc.ID)
+		if !bc.Shared {
+			step.Reclaim += bc.Size
+		}
+	}
+	step.Cmd = DockerBuilderPruneCmd(DockerBuilderPruneOption{All: ptr(true), Force: ptr(true)}, p.untilFilter())
+	p.add(step)
+}
+
+// volumes removes the unused volumes, named ones included.
+func (p *gcPlanner) volumes() {
+	if p.done() {
+		return
+	}
+
+	step := GCStep{Kind: GCVolumes}
+	for _, v := range p.du.VolumeDetails {
+		if v.Links != 0 || p.protected[v.Name] {
+			continue
+		}
+		step.Targets = append(step.Targets, v.Name)
+		step.Reclaim += positive(v.Size)
+	}
+	step.Cmd = DockerVolumeRmCmd(DockerVolumeRmOption{}, step.Targets)
+	p.add(step)
+}
+
+// networks prunes unused networks, which are not listed by
+// `docker system df` and reclaim no space.
+func (p *gcPlanner) networks() {
+	if p.done() {
+		return
+	}
+
+	step := GCStep{Kind: GCNetworks}
+	step.Cmd = DockerNetworkPruneCmd(DockerNetworkPruneOption{Force: ptr(true)}, p.filters())
+	p.plan.Steps = append(p.plan.Steps, p.withGlobal(step))
+}
+
+// add adds step to the plan if it removes anything.
+func (p *gcPlanner) add(step GCStep) {
+	if len(step.Targets) == 0 {
+		return
+	}
+	p.plan.Steps = append(p.plan.Steps, p.withGlobal(step))
+	p.plan.Reclaim += step.Reclaim
+}
+
+func (p *gcPlanner) withGlobal(step GCStep) GCStep {
+	step.Cmd = withGlobal(step.Cmd, p.opt)
+	return step
+}
+
+// done reports whether the plan is expected to reach the target.
+func (p *gcPlanner) done() bool {
+	return p.plan.Need > 0 && p.plan.Reclaim >= p.plan.Need
+}
+
+func (p *gcPlanner) recent(t time.Time) bool {
+	return p.policy.KeepRecent > 0 && time.Since(t) < p.policy.KeepRecent
+}
+
+func (p *gcPlanner) removable(img ImageUsage) bool {
+	return !p.inUse[img.ID] && !p.protected[img.ID] && !p.recent(img.Created)
+}
+
+/*
+filters returns the --filter flags of `docker network prune` for the
+policy's KeepRecent and ProtectLabels. They are passed as arguments, which
+docker parses as flags since prune commands take no arguments.
+*/
+func (p *gcPlanner) filters() []string {
+	args := p.untilFilter()
+	for _, label := range p.policy.ProtectLabels {
+		args = append(args, "--filter=label!="+label)
+	}
+	return args
+}
+
+func (p *gcPlanner) untilFilter() []string {
+	if p.policy.KeepRecent <= 0 {
+		return nil
+	}
+	return []string{"--filter=until=" + p.policy.KeepRecent.String()}
+}
+
+func positive(n int64) int64 {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
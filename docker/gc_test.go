@@ -0,0 +1,182 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testPlanner(policy GCPolicy, du *DiskUsage) *gcPlanner {
+	p := &gcPlanner{
+		policy:    policy,
+		plan:      &GCPlan{},
+		du:        du,
+		protected: map[string]bool{},
+		inUse:     map[string]bool{},
+		refs:      map[string][]string{},
+		repos:     map[string][]string{},
+		images:    map[string]ImageUsage{},
+	}
+	for _, img := range du.ImageDetails {
+		p.images[img.ID] = img
+	}
+	return p
+}
+
+func TestGCPlannerContainers(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	p := testPlanner(GCPolicy{KeepRecent: time.Hour}, &DiskUsage{
+		ContainerDetails: []ContainerUsage{
+			{ID: "exited", State: "exited", Created: old, Size: 10},
+			{ID: "running", State: "running", Created: old, Size: 20},
+			{ID: "protected", State: "exited", Created: old, Size: 40},
+			{ID: "recent", State: "exited", Created: time.Now(), Size: 80},
+		},
+	})
+	p.protected["protected"] = true
+	p.containers()
+
+	if len(p.plan.Steps) != 1 {
+		t.Fatalf("steps = %d, want 1", len(p.plan.Steps))
+	}
+	step := p.plan.Steps[0]
+	if want := []string{"exited"}; !reflect.DeepEqual(step.Targets, want) {
+		t.Errorf("Targets = %q, want %q", step.Targets, want)
+	}
+	if step.Reclaim != 10 || p.plan.Reclaim != 10 {
+		t.Errorf("Reclaim = %d, plan %d, want 10", step.Reclaim, p.plan.Reclaim)
+	}
+	if want := []string{"docker", "rm", "exited"}; !reflect.DeepEqual(step.Cmd.Args, want) {
+		t.Errorf("Args = %q, want %q", step.Cmd.Args, want)
+	}
+	if !p.removed["exited"] || p.removed["running"] {
+		t.Errorf("removed = %v", p.removed)
+	}
+}
+
+func TestGCPlannerImages(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2024, 1, n, 0, 0, 0, 0, time.UTC) }
+	p := testPlanner(GCPolicy{KeepTags: 1}, &DiskUsage{
+		ImageDetails: []ImageUsage{
+			{ID: "dangling", Created: day(1), UniqueSize: 1},
+			{ID: "dangling-used", Created: day(1), UniqueSize: 2},
+			{ID: "app-1", Created: day(1), UniqueSize: 4},
+			{ID: "app-2", Created: day(2), UniqueSize: 8},
+			{ID: "app-3", Created: day(3), UniqueSize: 16},
+			{ID: "shared", Created: day(1), UniqueSize: 32},
+		},
+	})
+	p.inUse["dangling-used"] = true
+	p.refs = map[string][]string{
+		"app-1":  {"app:1"},
+		"app-2":  {"app:2"},
+		"app-3":  {"app:3"},
+		"shared": {"app:old", "base:latest"},
+	}
+	p.repos = map[string][]string{
+		"app":  {"app-1", "app-2", "app-3", "shared"},
+		"base": {"shared"},
+	}
+
+	p.danglingImages()
+	p.taggedImages()
+
+	if len(p.plan.Steps) != 2 {
+		t.Fatalf("steps = %d, want 2", len(p.plan.Steps))
+	}
+	dangling, tagged := p.plan.Steps[0], p.plan.Steps[1]
+	if want := []string{"docker", "image", "rm", "dangling"}; !reflect.DeepEqual(dangling.Cmd.Args, want) {
+		t.Errorf("dangling Args = %q, want %q", dangling.Cmd.Args, want)
+	}
+	// shared keeps its only tag in base, so it stays.
+	if want := []string{"app:1", "app:2"}; !reflect.DeepEqual(tagged.Targets, want) {
+		t.Errorf("tagged Targets = %q, want %q", tagged.Targets, want)
+	}
+	if tagged.Reclaim != 12 || p.plan.Reclaim != 13 {
+		t.Errorf("Reclaim = %d, plan %d, want 12, 13", tagged.Reclaim, p.plan.Reclaim)
+	}
+}
+
+func TestGCPlannerTarget(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2024, 1, n, 0, 0, 0, 0, time.UTC) }
+	p := testPlanner(GCPolicy{KeepTags: 1}, &DiskUsage{
+		ImageDetails: []ImageUsage{
+			{ID: "a", Created: day(1), UniqueSize: 100},
+			{ID: "b", Created: day(2), UniqueSize: 100},
+			{ID: "c", Created: day(3), UniqueSize: 100},
+		},
+	})
+	p.refs = map[string][]string{"a": {"app:a"}, "b": {"app:b"}, "c": {"app:c"}}
+	p.repos = map[string][]string{"app": {"a", "b", "c"}}
+	p.plan.Need = 50
+
+	p.taggedImages()
+	p.volumes()
+
+	if len(p.plan.Steps) != 1 {
+		t.Fatalf("steps = %d, want 1", len(p.plan.Steps))
+	}
+	if want := []string{"app:a"}; !reflect.DeepEqual(p.plan.Steps[0].Targets, want) {
+		t.Errorf("Targets = %q, want %q, the oldest image reaching the target", p.plan.Steps[0].Targets, want)
+	}
+}
+
+func TestGCPlannerVolumesAndBuildCache(t *testing.T) {
+	p := testPlanner(GCPolicy{KeepRecent: time.Hour, ProtectLabels: []string{"keep"}}, &DiskUsage{
+		VolumeDetails: []VolumeUsage{
+			{Name: "unused", Size: 1},
+			{Name: "mounted", Links: 1, Size: 2},
+			{Name: "protected", Size: 4},
+		},
+		BuildCacheDetails: []BuildCacheUsage{
+			{ID: "old", LastUsed: time.Now().Add(-48 * time.Hour), Size: 8},
+			{ID: "shared", Created: time.Now().Add(-48 * time.Hour), Shared: true, Size: 16},
+			{ID: "used", LastUsed: time.Now(), Size: 32},
+			{ID: "busy", InUse: true, Size: 64},
+		},
+	})
+	p.protected["protected"] = true
+
+	p.volumes()
+	p.buildCache()
+	p.networks()
+
+	if len(p.plan.Steps) != 3 {
+		t.Fatalf("steps = %d, want 3", len(p.plan.Steps))
+	}
+	volumes, cache, networks := p.plan.Steps[0], p.plan.Steps[1], p.plan.Steps[2]
+	if want := []string{"docker", "volume", "rm", "unused"}; !reflect.DeepEqual(volumes.Cmd.Args, want) {
+		t.Errorf("volumes Args = %q, want %q", volumes.Cmd.Args, want)
+	}
+	if want := []string{"old", "shared"}; !reflect.DeepEqual(cache.Targets, want) || cache.Reclaim != 8 {
+		t.Errorf("build cache Targets, Reclaim = %q, %d, want %q, 8", cache.Targets, cache.Reclaim, want)
+	}
+	if want := []string{"docker", "builder", "prune", "--all=true", "--force=true", "--filter=until=1h0m0s"}; !reflect.DeepEqual(cache.Cmd.Args, want) {
+		t.Errorf("build cache Args = %q, want %q", cache.Cmd.Args, want)
+	}
+	if want := []string{"docker", "network", "prune", "--force=true", "--filter=until=1h0m0s", "--filter=label!=keep"}; !reflect.DeepEqual(networks.Cmd.Args, want) {
+		t.Errorf("networks Args = %q, want %q", networks.Cmd.Args, want)
+	}
+	if p.plan.Reclaim != 9 {
+		t.Errorf("plan Reclaim = %d, want 9", p.plan.Reclaim)
+	}
+}
+
+func TestGCStepReport(t *testing.T) {
+	tests := []struct {
+		kind GCKind
+		out  string
+		want *PruneReport
+	}{
+		{GCContainers, "abc\ndef\n", &PruneReport{Containers: []string{"abc", "def"}}},
+		{GCVolumes, "data\n", &PruneReport{Volumes: []string{"data"}}},
+		{GCImages, "Untagged: app:1\nDeleted: sha256:abc\n", &PruneReport{Images: []string{"sha256:abc"}, Untagged: []string{"app:1"}}},
+		{GCBuildCache, "Deleted build cache objects:\nxyz\n\nTotal reclaimed space: 1kB\n", &PruneReport{BuildCache: []string{"xyz"}, Reclaimed: 1000}},
+	}
+	for _, tt := range tests {
+		got := GCStep{Kind: tt.kind}.report([]byte(tt.out))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s report = %+v, want %+v", tt.kind, got, tt.want)
+		}
+	}
+}
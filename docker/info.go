@@ -0,0 +1,274 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Info is the output of `docker info --format '{{json .}}'`: the daemon's
+// system information followed by the client's.
+type Info struct {
+	ID            string
+	Name          string
+	ServerVersion string
+
+	Containers        int
+	ContainersRunning int
+	ContainersPaused  int
+	ContainersStopped int
+	Images            int
+
+	// Driver is the storage driver, with its status lines in
+	// DriverStatus, e.g. ["Backing Filesystem", "extfs"].
+	Driver        string
+	DriverStatus  [][2]string
+	DockerRootDir string
+
+	Plugins PluginsInfo
+
+	MemoryLimit        bool
+	SwapLimit          bool
+	KernelMemory       bool
+	KernelMemoryTCP    bool
+	CPUCfsPeriod       bool `json:"CpuCfsPeriod"`
+	CPUCfsQuota        bool `json:"CpuCfsQuota"`
+	CPUShares          bool
+	CPUSet             bool
+	PidsLimit          bool
+	IPv4Forwarding     bool
+	BridgeNfIptables   bool
+	BridgeNfIP6tables  bool `json:"BridgeNfIp6tables"`
+	OomKillDisable     bool
+	Debug              bool
+	ExperimentalBuild  bool
+	LiveRestoreEnabled bool
+
+	NFd             int
+	NGoroutines     int
+	NEventsListener int
+	SystemTime      string
+
+	LoggingDriver string
+	CgroupDriver  string
+
+	// CgroupVersion is "1" or "2".
+	CgroupVersion string
+
+	KernelVersion   string
+	OperatingSystem string
+	OSVersion       string
+	OSType          string
+	Architecture    string
+	NCPU            int
+	MemTotal        int64
+
+	IndexServerAddress  string
+	RegistryConfig      *RegistryConfig
+	HTTPProxy           string `json:"HttpProxy"`
+	HTTPSProxy          string `json:"HttpsProxy"`
+	NoProxy             string
+	DefaultAddressPools []AddressPool
+
+	Labels []string
+
+	Runtimes       map[string]Runtime
+	DefaultRuntime string
+	Isolation      string
+	InitBinary     string
+
+	ContainerdCommit Commit
+	RuncCommit       Commit
+	InitCommit       Commit
+
+	// SecurityOptions are entries like "name=seccomp,profile=default";
+	// see SecurityOption.
+	SecurityOptions []string
+
+	Swarm SwarmInfo
+
+	ProductLicense string
+	Warnings       []string
+	ServerErrors   []string
+
+	ClientInfo   *ClientInfo
+	ClientErrors []string
+}
+
+// PluginsInfo lists the plugins of the daemon by kind.
+type PluginsInfo struct {
+	Volume        []string
+	Network       []string
+	Authorization []string
+	Log           []string
+}
+
+// RegistryConfig is the registry configuration of the daemon.
+type RegistryConfig struct {
+	AllowNondistributableArtifactsCIDRs     []string
+	AllowNondistributableArtifactsHostnames []string
+	InsecureRegistryCIDRs                   []string
+	IndexConfigs                            map[string]IndexInfo
+	Mirrors                                 []string
+}
+
+// IndexInfo describes a registry.
+type IndexInfo struct {
+	Name     string
+	Mirrors  []string
+	Secure   bool
+	Official bool
+}
+
+// AddressPool is a default pool for network subnets.
+type AddressPool struct {
+	Base string
+	Size int
+}
+
+// Runtime is an OCI runtime known to the daemon.
+type Runtime struct {
+	Path string
+	Args []string `json:"runtimeArgs"`
+}
+
+// Commit is the commit of a component the daemon runs, and the commit the
+// daemon was built against.
+type Commit struct {
+	ID       string
+	Expected string
+}
+
+// SwarmInfo is the swarm state of the daemon's node.
+type SwarmInfo struct {
+	NodeID   string
+	NodeAddr string
+
+	// LocalNodeState is "inactive", "pending", "active", "error" or
+	// "locked".
+	LocalNodeState string
+
+	// ControlAvailable is set on managers.
+	ControlAvailable bool
+
+	Error          string
+	RemoteManagers []SwarmPeer
+	Nodes          int
+	Managers       int
+	Cluster        *ClusterInfo
+	Warnings       []string
+}
+
+// SwarmPeer is a manager known to the node.
+type SwarmPeer struct {
+	NodeID string
+	Addr   string
+}
+
+// ClusterInfo describes the swarm; it is only reported by managers.
+type ClusterInfo struct {
+	ID                     string
+	CreatedAt              string
+	UpdatedAt              string
+	RootRotationInProgress bool
+	DefaultAddrPool        []string
+	SubnetSize             uint32
+	DataPathPort           uint32
+}
+
+// ClientInfo describes the docker CLI.
+type ClientInfo struct {
+	Debug    bool
+	Context  string
+	Plugins  []ClientPlugin
+	Warnings []string
+}
+
+// ClientPlugin is a CLI plugin such as buildx or compose.
+type ClientPlugin struct {
+	Name             string
+	Path             string
+	SchemaVersion    string
+	Vendor           string
+	Version          string
+	ShortDescription string
+	URL              string
+	ShadowedPaths    []string
+}
+
+// ParseInfo decodes the output of `docker info --format '{{json .}}'`.
+func ParseInfo(data []byte) (*Info, error) {
+	var info Info
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetInfo runs `docker info` with the global options opt.
+func GetInfo(ctx context.Context, opt DockerOption) (*Info, error) {
+	cmd := withGlobal(DockerInfoCmd(DockerInfoOption{Format: ptr("{{json .}}")}, nil), opt)
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInfo(out)
+}
+
+// Info returns the system information of the client's daemon. Unlike
+// Version it is not cached, as counts and swarm state change.
+func (c *Client) Info(ctx context.Context) (*Info, error) {
+	return GetInfo(ctx, c.Global)
+}
+
+// SecurityOption returns the properties of the security option called
+// name, e.g. {"name": "seccomp", "profile": "default"} for "seccomp".
+func (i *Info) SecurityOption(name string) (map[string]string, bool) {
+	for _, opt := range i.SecurityOptions {
+		props := map[string]string{}
+		for _, kv := range strings.Split(opt, ",") {
+			k, v, _ := strings.Cut(kv, "=")
+			props[k] = v
+		}
+		if props["name"] == name {
+			return props, true
+		}
+	}
+	return nil, false
+}
+
+// Rootless reports whether the daemon runs in rootless mode.
+func (i *Info) Rootless() bool {
+	_, ok := i.SecurityOption("rootless")
+	return ok
+}
+
+// SwarmActive reports whether the node is part of a swarm.
+func (i *Info) SwarmActive() bool {
+	return i.Swarm.LocalNodeState == "active"
+}
+
+// SwarmManager reports whether the node is a swarm manager.
+func (i *Info) SwarmManager() bool {
+	return i.SwarmActive() && i.Swarm.ControlAvailable
+}
+
+// DriverStatusValue returns the storage driver status line called key.
+func (i *Info) DriverStatusValue(key string) (string, bool) {
+	for _, kv := range i.DriverStatus {
+		if kv[0] == key {
+			return kv[1], true
+		}
+	}
+	return "", false
+}
+
+// Features returns the daemon features known from the system information;
+// the API version is only reported by Version.
+func (i *Info) Features() *DaemonFeatures {
+	return &DaemonFeatures{
+		OSType:       i.OSType,
+		Experimental: ptr(i.ExperimentalBuild),
+		Swarm:        ptr(i.SwarmActive()),
+	}
+}
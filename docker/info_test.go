@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+)
+
+const infoOutput = `{
+	"ID": "7TRN:IPZB",
+	"Containers": 3, "ContainersRunning": 1, "ContainersPaused": 0, "ContainersStopped": 2, "Images": 12,
+	"Driver": "overlay2",
+	"DriverStatus": [["Backing Filesystem", "extfs"], ["Supports d_type", "true"]],
+	"Plugins": {"Volume": ["local"], "Network": ["bridge", "host"], "Authorization": null, "Log": ["json-file"]},
+	"CpuCfsPeriod": true,
+	"BridgeNfIp6tables": true,
+	"ExperimentalBuild": true,
+	"CgroupVersion": "2",
+	"OSType": "linux",
+	"NCPU": 8,
+	"MemTotal": 16624185344,
+	"HttpProxy": "http://proxy:3128",
+	"RegistryConfig": {"IndexConfigs": {"docker.io": {"Name": "docker.io", "Mirrors": [], "Secure": true, "Official": true}}},
+	"Runtimes": {"runc": {"path": "runc"}, "crun": {"path": "/usr/bin/crun", "runtimeArgs": ["--debug"]}},
+	"ContainerdCommit": {"ID": "abc", "Expected": "abc"},
+	"SecurityOptions": ["name=apparmor", "name=seccomp,profile=builtin", "name=rootless", "name=cgroupns"],
+	"Swarm": {"NodeID": "n1", "LocalNodeState": "active", "ControlAvailable": true, "RemoteManagers": [{"NodeID": "n1", "Addr": "10.0.0.1:2377"}], "Nodes": 1, "Managers": 1},
+	"Warnings": ["WARNING: No swap limit support"],
+	"ClientInfo": {"Debug": false, "Context": "default", "Plugins": [{"Name": "buildx", "Version": "v0.11.2", "Path": "/usr/libexec/docker/cli-plugins/docker-buildx"}], "Warnings": null}
+}`
+
+func TestParseInfo(t *testing.T) {
+	info, err := ParseInfo([]byte(infoOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.ContainersStopped != 2 || info.Images != 12 || info.NCPU != 8 || info.MemTotal != 16624185344 {
+		t.Errorf("counts = %d/%d/%d/%d", info.ContainersStopped, info.Images, info.NCPU, info.MemTotal)
+	}
+	if !info.CPUCfsPeriod || !info.BridgeNfIP6tables || info.HTTPProxy != "http://proxy:3128" {
+		t.Errorf("renamed fields not decoded: %+v", info)
+	}
+	if got := info.Runtimes["crun"]; got.Path != "/usr/bin/crun" || !reflect.DeepEqual(got.Args, []string{"--debug"}) {
+		t.Errorf("Runtimes[crun] = %+v", got)
+	}
+	if !info.RegistryConfig.IndexConfigs["docker.io"].Official {
+		t.Errorf("RegistryConfig = %+v", info.RegistryConfig)
+	}
+	if info.ClientInfo == nil || len(info.ClientInfo.Plugins) != 1 || info.ClientInfo.Plugins[0].Name != "buildx" {
+		t.Errorf("ClientInfo = %+v", info.ClientInfo)
+	}
+
+	if v, ok := info.DriverStatusValue("Backing Filesystem"); !ok || v != "extfs" {
+		t.Errorf("DriverStatusValue(Backing Filesystem) = %q, %v", v, ok)
+	}
+	if _, ok := info.DriverStatusValue("Nope"); ok {
+		t.Error("DriverStatusValue(Nope) found")
+	}
+
+	if props, ok := info.SecurityOption("seccomp"); !ok || props["profile"] != "builtin" {
+		t.Errorf("SecurityOption(seccomp) = %v, %v", props, ok)
+	}
+	if _, ok := info.SecurityOption("selinux"); ok {
+		t.Error("SecurityOption(selinux) found")
+	}
+	if !info.Rootless() || !info.SwarmActive() || !info.SwarmManager() {
+		t.Errorf("Rootless/SwarmActive/SwarmManager = %v/%v/%v", info.Rootless(), info.SwarmActive(), info.SwarmManager())
+	}
+
+	d := info.Features()
+	if d.OSType != "linux" || d.APIVersion != "" || d.Swarm == nil || !*d.Swarm {
+		t.Errorf("Features() = %+v", d)
+	}
+}
+
+func TestParseInfoSwarmStates(t *testing.T) {
+	tests := []struct {
+		swarm   string
+		active  bool
+		manager bool
+	}{
+		{`{"LocalNodeState": "inactive"}`, false, false},
+		{`{"LocalNodeState": "active", "ControlAvailable": false}`, true, false},
+		{`{"LocalNodeState": "locked", "ControlAvailable": true}`, false, false},
+	}
+
+	for _, tt := range tests {
+		info, err := ParseInfo([]byte(`{"Swarm": ` + tt.swarm + `}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.SwarmActive() != tt.active || info.SwarmManager() != tt.manager {
+			t.Errorf("%s: SwarmActive/SwarmManager = %v/%v, want %v/%v", tt.swarm, info.SwarmActive(), info.SwarmManager(), tt.active, tt.manager)
+		}
+	}
+
+	if _, err := ParseInfo([]byte("Cannot connect")); err == nil {
+		t.Error("ParseInfo accepted invalid output")
+	}
+}
@@ -0,0 +1,428 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// nonInterspersed lists the commands that stop parsing flags at their first
+// positional argument, so that the flags of the command run inside the
+// container (or of the image tagged) are passed through as arguments.
+var nonInterspersed = map[string]bool{
+	"docker":                  true,
+	"docker commit":           true,
+	"docker container commit": true,
+	"docker container create": true,
+	"docker container exec":   true,
+	"docker container run":    true,
+	"docker container top":    true,
+	"docker create":           true,
+	"docker exec":             true,
+	"docker image tag":        true,
+	"docker run":              true,
+	"docker service create":   true,
+	"docker tag":              true,
+	"docker top":              true,
+}
+
+/*
+ParsedCommand is a docker command line decoded into the generated option
+structs. For `docker -H ssh://host run -d --name web nginx` it holds:
+
+	Name:   "DockerRun"
+	Path:   []string{"docker", "run"}
+	Global: DockerOption{Host: []string{"ssh://host"}}
+	Option: &DockerRunOption{Detach: ptr(true), Name: ptr("web")}
+	Args:   []string{"nginx"}
+*/
+type ParsedCommand struct {
+	// Name is the generated identifier of the command; Name + "Cmd" is
+	// the wrapper function and Name + "Option" its option struct.
+	Name string
+
+	// Path is the full command path starting with "docker", with aliases
+	// resolved: `docker container ps` has the path of `docker container ls`.
+	Path []string
+
+	// Global holds the flags given before the command name.
+	Global DockerOption
+
+	// Option points to the command's option struct, e.g. *DockerRunOption,
+	// or is nil for commands without flags.
+	Option interface{}
+
+	// Args are the positional arguments.
+	Args []string
+
+	// Repeated holds, by flag name, the values of repeatable flags such as
+	// --filter or --mount given more than once where the option field
+	// holds a single value. The field keeps the last value; Cmd passes all
+	// of them.
+	Repeated map[string][]string
+
+	info *CommandInfo
+}
+
+// repeatableTypes are the flag types that docker collects every value of
+// when the flag is repeated. The generated option structs hold a single
+// value for most of them.
+var repeatableTypes = map[string]bool{
+	"config":         true,
+	"external-ca":    true,
+	"filter":         true,
+	"gpu-request":    true,
+	"ipNetSlice":     true,
+	"mount":          true,
+	"network":        true,
+	"port":           true,
+	"pref":           true,
+	"secret":         true,
+	"stringArray":    true,
+	"stringSlice":    true,
+	"stringToString": true,
+	"ulimit":         true,
+}
+
+/*
+ParseArgs parses a docker command line given as argv, with or without the
+leading "docker", and returns the matching command with its flags set on the
+generated option struct.
+
+Flags are accepted as --flag=value, --flag value, -f value, -fvalue and
+combined boolean shorthands such as -it. Repeated flags are appended to
+slice and map fields; other fields keep the last value, and the values of
+repeatable flags such as --filter are kept in Repeated. "--" ends flag
+parsing.
+*/
+func ParseArgs(argv []string) (*ParsedCommand, error) {
+	args := argv
+	if len(args) > 0 && isDockerBinary(args[0]) {
+		args = args[1:]
+	}
+
+	p := &ParsedCommand{info: lookupCommand([]string{"docker"})}
+
+	rest, err := parseFlags(p.info, &p.Global, args, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(rest) > 0 {
+		c := lookupSubcommand(p.info, rest[0])
+		if c == nil {
+			break
+		}
+		p.info = c
+		rest = rest[1:]
+	}
+	p.Name = p.info.Name
+	p.Path = append([]string(nil), p.info.Path...)
+
+	if len(p.Path) == 1 {
+		if len(rest) > 0 {
+			return nil, fmt.Errorf("unknown command %q for \"docker\"", rest[0])
+		}
+		p.Option = &p.Global
+		return p, nil
+	}
+
+	if p.info.option != nil {
+		p.Option = p.info.option()
+	}
+	repeated := map[string][]string{}
+	p.Args, err = parseFlags(p.info, p.Option, rest, !nonInterspersed[strings.Join(p.Path, " ")], repeated)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", strings.Join(p.Path, " "), err)
+	}
+	for name, values := range repeated {
+		if len(values) > 1 {
+			if p.Repeated == nil {
+				p.Repeated = map[string][]string{}
+			}
+			p.Repeated[name] = values
+		}
+	}
+	if p.Args == nil {
+		p.Args = []string{}
+	}
+
+	return p, nil
+}
+
+// ParseCommandLine splits s into words with SplitShellWords and parses them
+// with ParseArgs.
+func ParseCommandLine(s string) (*ParsedCommand, error) {
+	argv, err := SplitShellWords(s)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArgs(argv)
+}
+
+// Cmd rebuilds the command with the generated wrapper function, so that a
+// parsed command line can be run or compared with the one it came from.
+func (p *ParsedCommand) Cmd() *exec.Cmd {
+	if len(p.Path) == 1 {
+		return DockerCmd(p.Global, p.Args)
+	}
+
+	args := p.Args
+	if !nonInterspersed[strings.Join(p.Path, " ")] {
+		for _, arg := range args {
+			if strings.HasPrefix(arg, "-") && arg != "-" {
+				// Keep arguments such as "-weird" from being parsed as flags.
+				args = append([]string{"--"}, args...)
+				break
+			}
+		}
+	}
+
+	opt := p.Option
+	if len(p.Repeated) > 0 {
+		// Pass the repeated flags before the arguments, in place of the
+		// single value their field holds.
+		v := reflect.New(reflect.TypeOf(opt).Elem())
+		v.Elem().Set(reflect.ValueOf(opt).Elem())
+		var flags []string
+		for _, f := range p.info.Flags {
+			values, ok := p.Repeated[f.Name]
+			if !ok {
+				continue
+			}
+			field := v.Elem().FieldByName(f.Field)
+			field.Set(reflect.Zero(field.Type()))
+			for _, value := range values {
+				flags = append(flags, "--"+f.Name+"="+value)
+			}
+		}
+		opt = v.Interface()
+		args = append(flags, args...)
+	}
+	return withGlobal(p.info.cmd(opt, args), p.Global)
+}
+
+func isDockerBinary(arg string) bool {
+	name := filepath.Base(arg)
+	return name == "docker" || name == "docker.exe"
+}
+
+// parseFlags sets the flags in args on opt and returns the positional
+// arguments. If repeated is not nil, it collects the values of repeatable
+// flags whose field holds a single value.
+func parseFlags(c *CommandInfo, opt interface{}, args []string, interspersed bool, repeated map[string][]string) ([]string, error) {
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return append(positional, args[i+1:]...), nil
+
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := strings.Cut(arg[2:], "=")
+			f, ok := c.Flag(name)
+			if !ok {
+				return nil, fmt.Errorf("unknown flag: --%s", name)
+			}
+			if !hasValue && !isBoolField(opt, f) {
+				if i+1 >= len(args) {
+					return nil, fmt.Errorf("flag needs an argument: --%s", name)
+				}
+				i++
+				value = args[i]
+			} else if !hasValue {
+				value = "true"
+			}
+			if err := setFlag(opt, f, value); err != nil {
+				return nil, err
+			}
+			collect(repeated, opt, f, value)
+
+		case strings.HasPrefix(arg, "-") && arg != "-":
+			shorthands := arg[1:]
+			for len(shorthands) > 0 {
+				short := shorthands[:1]
+				shorthands = shorthands[1:]
+
+				f, ok := c.ShorthandFlag(short)
+				if !ok {
+					return nil, fmt.Errorf("unknown shorthand flag: %q in %s", short, arg)
+				}
+
+				var value string
+				switch {
+				case strings.HasPrefix(shorthands, "="):
+					value, shorthands = shorthands[1:], ""
+				case isBoolField(opt, f):
+					value = "true"
+				case shorthands != "":
+					value, shorthands = shorthands, ""
+				case i+1 < len(args):
+					i++
+					value = args[i]
+				default:
+					return nil, fmt.Errorf("flag needs an argument: %q in %s", short, arg)
+				}
+				if err := setFlag(opt, f, value); err != nil {
+					return nil, err
+				}
+				collect(repeated, opt, f, value)
+			}
+
+		default:
+			positional = append(positional, arg)
+			if !interspersed {
+				return append(positional, args[i+1:]...), nil
+			}
+		}
+	}
+
+	return positional, nil
+}
+
+func collect(repeated map[string][]string, opt interface{}, f FlagInfo, value string) {
+	if repeated == nil || !repeatableTypes[f.Type] {
+		return
+	}
+	if reflect.ValueOf(opt).Elem().FieldByName(f.Field).Kind() == reflect.Ptr {
+		repeated[f.Name] = append(repeated[f.Name], value)
+	}
+}
+
+func isBoolField(opt interface{}, f FlagInfo) bool {
+	field := reflect.ValueOf(opt).Elem().FieldByName(f.Field)
+	return field.Type() == reflect.TypeOf((*bool)(nil))
+}
+
+func setFlag(opt interface{}, f FlagInfo, value string) error {
+	field := reflect.ValueOf(opt).Elem().FieldByName(f.Field)
+
+	switch field.Kind() {
+	case reflect.Slice:
+		field.Set(reflect.Append(field, reflect.ValueOf(value)))
+		return nil
+	case reflect.Map:
+		if field.IsNil() {
+			field.Set(reflect.MakeMap(field.Type()))
+		}
+		key, val, _ := strings.Cut(value, "=")
+		field.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+		return nil
+	}
+
+	elem := reflect.New(field.Type().Elem())
+	var err error
+	switch e := elem.Elem(); e.Kind() {
+	case reflect.String:
+		e.SetString(value)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(value)
+		e.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(value, 0, e.Type().Bits())
+		e.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		n, err = strconv.ParseUint(value, 0, e.Type().Bits())
+		e.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		n, err = strconv.ParseFloat(value, e.Type().Bits())
+		e.SetFloat(n)
+	default:
+		err = fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return fmt.Errorf("invalid argument %q for --%s flag: %w", value, f.Name, err)
+	}
+
+	field.Set(elem)
+	return nil
+}
+
+/*
+SplitShellWords splits a POSIX shell command line into words, the inverse of
+ShellJoin. It handles single and double quotes, backslash escapes, line
+continuations and comments, but performs no variable or glob expansion:
+`$HOME` is kept as written.
+*/
+func SplitShellWords(s string) ([]string, error) {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+
+		case c == '#' && !inWord:
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+
+		case c == '\\':
+			if i+1 >= len(s) {
+				return nil, errors.New("unterminated backslash escape")
+			}
+			i++
+			if s[i] == '\n' {
+				continue
+			}
+			word.WriteByte(s[i])
+			inWord = true
+
+		case c == '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated single quote")
+			}
+			word.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+
+		case c == '"':
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				word.WriteByte(s[i])
+			}
+			if i >= len(s) {
+				return nil, errors.New("unterminated double quote")
+			}
+			inWord = true
+
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
@@ -0,0 +1,132 @@
+package docker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCommandLineRoundTrip(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{
+			line: "docker ps --filter status=exited --filter label=x",
+			want: []string{"docker", "ps", "--filter=status=exited", "--filter=label=x"},
+		},
+		{
+			line: "docker ps --filter=status=exited -f label=x -flabel=y",
+			want: []string{"docker", "ps", "--filter=status=exited", "--filter=label=x", "--filter=label=y"},
+		},
+		{
+			line: "docker run --mount type=bind,src=/a,dst=/a --mount type=tmpfs,dst=/tmp alpine",
+			want: []string{"docker", "run", "--mount=type=bind,src=/a,dst=/a", "--mount=type=tmpfs,dst=/tmp", "alpine"},
+		},
+		{
+			line: "docker ps --filter status=exited",
+			want: []string{"docker", "ps", "--filter=status=exited"},
+		},
+		{
+			line: "docker -H ssh://host run -d --name web nginx",
+			want: []string{"docker", "--host", "ssh://host", "run", "--detach=true", "--name=web", "nginx"},
+		},
+		{
+			line: "docker run -it alpine sh -c 'ls -l'",
+			want: []string{"docker", "run", "--interactive=true", "--tty=true", "alpine", "sh", "-c", "ls -l"},
+		},
+		{
+			line: "docker container ps -a",
+			want: []string{"docker", "container", "ls", "--all=true"},
+		},
+		{
+			line: "docker rm -f web -- -weird",
+			want: []string{"docker", "rm", "--force=true", "--", "web", "-weird"},
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := ParseCommandLine(tt.line)
+		if err != nil {
+			t.Errorf("ParseCommandLine(%q): %v", tt.line, err)
+			continue
+		}
+		if got := p.Cmd().Args; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCommandLine(%q).Cmd().Args = %q, want %q", tt.line, got, tt.want)
+		}
+
+		again, err := ParseArgs(tt.want)
+		if err != nil {
+			t.Errorf("ParseArgs(%q): %v", tt.want, err)
+			continue
+		}
+		if !reflect.DeepEqual(again.Option, p.Option) || !reflect.DeepEqual(again.Repeated, p.Repeated) {
+			t.Errorf("ParseArgs(%q) = %+v, %q, want %+v, %q", tt.want, again.Option, again.Repeated, p.Option, p.Repeated)
+		}
+	}
+}
+
+func TestParseArgsRepeatedFilter(t *testing.T) {
+	p, err := ParseArgs([]string{"docker", "ps", "--filter", "status=exited", "--filter", "label=x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"filter": {"status=exited", "label=x"}}; !reflect.DeepEqual(p.Repeated, want) {
+		t.Errorf("Repeated = %q, want %q", p.Repeated, want)
+	}
+	if opt := p.Option.(*DockerPsOption); opt.Filter == nil || *opt.Filter != "label=x" {
+		t.Errorf("Filter = %v, want the last value", opt.Filter)
+	}
+}
+
+func TestParseArgsErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"docker", "ps", "--nope"}, "docker ps: unknown flag: --nope"},
+		{[]string{"docker", "ps", "--filter"}, "docker ps: flag needs an argument: --filter"},
+		{[]string{"docker", "ps", "--last", "x"}, `docker ps: invalid argument "x" for --last flag`},
+		{[]string{"docker", "frobnicate"}, `unknown command "frobnicate" for "docker"`},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseArgs(tt.args)
+		if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+			t.Errorf("ParseArgs(%q) error = %v, want prefix %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestSplitShellWords(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{in: "", want: nil},
+		{in: "a b\tc", want: []string{"a", "b", "c"}},
+		{in: `a 'b c' "d e"`, want: []string{"a", "b c", "d e"}},
+		{in: `"a\"b" 'a\b'`, want: []string{`a"b`, `a\b`}},
+		{in: `a\ b`, want: []string{"a b"}},
+		{in: "a \\\n b", want: []string{"a", "b"}},
+		{in: "a # comment\nb", want: []string{"a", "b"}},
+		{in: "a#b", want: []string{"a#b"}},
+		{in: `''`, want: []string{""}},
+		{in: `$HOME`, want: []string{"$HOME"}},
+		{in: `'a`, wantErr: true},
+		{in: `"a`, wantErr: true},
+		{in: `a\`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := SplitShellWords(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SplitShellWords(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitShellWords(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package docker
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DaemonFeatures describes the daemon a command runs against, as far as the
+// command and flag annotations depend on it. Empty or nil fields are
+// unknown and not checked.
+type DaemonFeatures struct {
+	// APIVersion is the API version of the daemon, e.g. "1.41".
+	APIVersion string
+
+	// OSType is the daemon operating system, "linux" or "windows".
+	OSType string
+
+	// Experimental reports whether experimental features are enabled.
+	Experimental *bool
+
+	// Swarm reports whether the daemon is part of a swarm.
+	Swarm *bool
+
+	// BuildKit reports whether builds use BuildKit.
+	BuildKit *bool
+}
+
+// UsageIssue is a use of a deprecated command or flag, or of one the daemon
+// does not support.
+type UsageIssue struct {
+	// Command is the command path, e.g. "docker run".
+	Command string
+
+	// Flag is the long flag name, or empty if the command itself is
+	// restricted.
+	Flag string
+
+	// Deprecated is set for deprecations, which docker still accepts.
+	Deprecated bool
+
+	Reason string
+}
+
+func (i UsageIssue) Error() string {
+	subject := i.Command
+	if i.Flag != "" {
+		subject += ": flag --" + i.Flag
+	}
+	return subject + " " + i.Reason
+}
+
+// UsageError is returned by UsagePolicy.Check when it rejects a command.
+type UsageError struct {
+	Issues []UsageIssue
+}
+
+func (e *UsageError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+/*
+UsagePolicy decides what happens to commands that use deprecated commands
+or flags, or ones the daemon described by Daemon does not support. Such
+commands are rejected with a *UsageError when Reject is set, and otherwise
+run after each issue has been passed to Warn.
+*/
+type UsagePolicy struct {
+	// Daemon describes the daemon; with nil only deprecations are found.
+	Daemon *DaemonFeatures
+
+	Reject bool
+
+	// Warn receives the issues of commands that are not rejected. If nil,
+	// they are written to the standard logger, like docker's own warnings.
+	Warn func(UsageIssue)
+}
+
+// Check checks cmd against the policy. Commands that cannot be parsed are
+// left for docker to report.
+func (p *UsagePolicy) Check(cmd *exec.Cmd) error {
+	issues, err := CheckCommand(cmd, p.Daemon)
+	if err != nil || len(issues) == 0 {
+		return nil
+	}
+
+	if p.Reject {
+		return &UsageError{Issues: issues}
+	}
+	warn := p.Warn
+	if warn == nil {
+		warn = logUsageIssue
+	}
+	for _, issue := range issues {
+		warn(issue)
+	}
+	return nil
+}
+
+func logUsageIssue(issue UsageIssue) {
+	log.Printf("WARNING: %v", issue)
+}
+
+// CheckCommand parses cmd with ParseArgs and reports the issues of the
+// parsed command, see ParsedCommand.Check.
+func CheckCommand(cmd *exec.Cmd, d *DaemonFeatures) ([]UsageIssue, error) {
+	p, err := ParseArgs(cmd.Args)
+	if err != nil {
+		return nil, err
+	}
+	return p.Check(d), nil
+}
+
+// Check reports the deprecated commands and flags used by p, and those
+// whose annotations d does not satisfy. The command is checked along with
+// its parents, so any `docker service` command requires a swarm.
+func (p *ParsedCommand) Check(d *DaemonFeatures) []UsageIssue {
+	command := strings.Join(p.Path, " ")
+
+	var issues []UsageIssue
+	for i := 2; i <= len(p.Path); i++ {
+		c := lookupCommand(p.Path[:i])
+		issues = append(issues, checkUsage(command, "", c.Deprecated, c.Annotations, d)...)
+	}
+	for _, f := range p.SetFlags() {
+		issues = append(issues, checkUsage(command, f.Name, f.Deprecated, f.Annotations, d)...)
+	}
+
+	return issues
+}
+
+// SetFlags returns the flags set on the command's option struct.
+func (p *ParsedCommand) SetFlags() []FlagInfo {
+	if p.Option == nil || len(p.Path) == 1 {
+		return nil
+	}
+
+	v := reflect.ValueOf(p.Option).Elem()
+	var flags []FlagInfo
+	for _, f := range p.info.Flags {
+		field := v.FieldByName(f.Field)
+		if !field.IsNil() && (field.Kind() == reflect.Ptr || field.Len() > 0) {
+			flags = append(flags, f)
+		}
+	}
+	return flags
+}
+
+func checkUsage(command, flag, deprecated string, a Annotations, d *DaemonFeatures) []UsageIssue {
+	var issues []UsageIssue
+	add := func(deprecated bool, format string, args ...interface{}) {
+		issues = append(issues, UsageIssue{
+			Command:    command,
+			Flag:       flag,
+			Deprecated: deprecated,
+			Reason:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	if deprecated != "" {
+		add(true, "is deprecated: %s", deprecated)
+	}
+	if d == nil {
+		return issues
+	}
+
+	if a.MinAPIVersion != "" && d.APIVersion != "" && versionLess(d.APIVersion, a.MinAPIVersion) {
+		add(false, "requires API version %s, but the daemon supports %s", a.MinAPIVersion, d.APIVersion)
+	}
+	if a.OSType != "" && d.OSType != "" && a.OSType != d.OSType {
+		add(false, "is only supported on %s daemons, but the daemon runs %s", a.OSType, d.OSType)
+	}
+	if a.Experimental && d.Experimental != nil && !*d.Experimental {
+		add(false, "requires a daemon with experimental features enabled")
+	}
+	if a.Swarm && d.Swarm != nil && !*d.Swarm {
+		add(false, "requires a swarm, but the daemon is not part of one")
+	}
+	if a.BuildKit && d.BuildKit != nil && !*d.BuildKit {
+		add(false, "requires BuildKit")
+	}
+	if a.NoBuildKit && d.BuildKit != nil && *d.BuildKit {
+		add(false, "is ignored by BuildKit")
+	}
+
+	return issues
+}
+
+// versionLess compares dotted version numbers such as API versions.
+func versionLess(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package docker
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestVersionLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.41", "1.42", true},
+		{"1.42", "1.41", false},
+		{"1.41", "1.41", false},
+		{"1.9", "1.10", true},
+		{"1.4", "1.40", true},
+		{"1", "1.0", false},
+		{"1.41", "1.41.1", true},
+		{"2.0", "1.99", false},
+	}
+	for _, tt := range tests {
+		if got := versionLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("versionLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCheckCommand(t *testing.T) {
+	old := &DaemonFeatures{APIVersion: "1.30", OSType: "linux", Swarm: ptr(false)}
+
+	tests := []struct {
+		line   string
+		daemon *DaemonFeatures
+		want   []string
+	}{
+		{"docker run alpine", old, nil},
+		{"docker run --platform linux/arm64 alpine", &DaemonFeatures{APIVersion: "1.41"}, nil},
+		{"docker run --platform linux/arm64 --cpu-count 2 alpine", old, []string{
+			"docker run: flag --cpu-count is only supported on windows daemons, but the daemon runs linux",
+			"docker run: flag --platform requires API version 1.32, but the daemon supports 1.30",
+		}},
+		{"docker run --platform linux/arm64 alpine", nil, nil},
+		{"docker service ls", old, []string{
+			"docker service ls requires a swarm, but the daemon is not part of one",
+		}},
+		{"docker context create --default-stack-orchestrator swarm ctx", nil, []string{
+			"docker context create: flag --default-stack-orchestrator is deprecated: Kubernetes stack and context support is deprecated",
+		}},
+	}
+
+	for _, tt := range tests {
+		argv, err := SplitShellWords(tt.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		issues, err := CheckCommand(exec.Command(argv[0], argv[1:]...), tt.daemon)
+		if err != nil {
+			t.Errorf("CheckCommand(%q): %v", tt.line, err)
+			continue
+		}
+		var got []string
+		for _, issue := range issues {
+			got = append(got, issue.Error())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CheckCommand(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+
+	if _, err := CheckCommand(exec.Command("docker", "run", "--nope"), nil); err == nil {
+		t.Error("CheckCommand accepted an unknown flag")
+	}
+}
+
+func TestUsagePolicyCheck(t *testing.T) {
+	cmd := exec.Command("docker", "context", "create", "--default-stack-orchestrator", "swarm", "ctx")
+
+	var usageErr *UsageError
+	if err := (&UsagePolicy{Reject: true}).Check(cmd); !errors.As(err, &usageErr) || len(usageErr.Issues) != 1 {
+		t.Errorf("Check with Reject = %v, want a *UsageError with one issue", err)
+	}
+
+	var warned []UsageIssue
+	p := &UsagePolicy{Warn: func(issue UsageIssue) { warned = append(warned, issue) }}
+	if err := p.Check(cmd); err != nil || len(warned) != 1 || !warned[0].Deprecated {
+		t.Errorf("Check = %v, warned %+v, want one deprecation warning", err, warned)
+	}
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	if err := (&UsagePolicy{}).Check(cmd); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "WARNING: docker context create: flag --default-stack-orchestrator is deprecated") {
+		t.Errorf("logged %q, want the deprecation warning", buf.String())
+	}
+}
@@ -0,0 +1,147 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// AddrFamily is the address family of a host binding.
+type AddrFamily string
+
+const (
+	IPv4 AddrFamily = "ipv4"
+	IPv6 AddrFamily = "ipv6"
+)
+
+// HostBinding is a host address a container port is published on.
+type HostBinding struct {
+	IP     string
+	Port   int
+	Family AddrFamily
+}
+
+func newHostBinding(ip string, port int) HostBinding {
+	family := IPv4
+	if strings.Contains(ip, ":") {
+		family = IPv6
+	}
+	return HostBinding{IP: ip, Port: port, Family: family}
+}
+
+// DialAddress returns the binding as a host:port address reachable from the
+// local host. Unspecified addresses are replaced by the loopback address of
+// the same family.
+func (b HostBinding) DialAddress() string {
+	ip := b.IP
+	switch {
+	case ip == "" || ip == "0.0.0.0":
+		ip = "127.0.0.1"
+	case ip == "::":
+		ip = "::1"
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(b.Port))
+}
+
+/*
+LocalAddress picks the binding best suited for connecting from the local
+host and returns its dial address. Bindings on all interfaces or on the
+loopback address are preferred, IPv4 before IPv6.
+*/
+func LocalAddress(bindings []HostBinding) (string, error) {
+	if len(bindings) == 0 {
+		return "", fmt.Errorf("no host bindings")
+	}
+
+	rank := func(b HostBinding) int {
+		r := 0
+		if b.Family == IPv6 {
+			r++
+		}
+		if ip := net.ParseIP(b.IP); b.IP != "" && ip != nil && !ip.IsUnspecified() && !ip.IsLoopback() {
+			r += 2
+		}
+		return r
+	}
+
+	best := bindings[0]
+	for _, b := range bindings[1:] {
+		if rank(b) < rank(best) {
+			best = b
+		}
+	}
+
+	return best.DialAddress(), nil
+}
+
+// ContainerPorts returns the host bindings of every published port of the
+// container, keyed by private port and protocol, e.g. "80/tcp".
+func ContainerPorts(ctx context.Context, container string) (map[string][]HostBinding, error) {
+	out, err := output(ctx, DockerPortCmd([]string{container}))
+	if err != nil {
+		return nil, err
+	}
+	return ParsePortOutput(string(out), "")
+}
+
+// PortBindings returns the host bindings of a single private port of the
+// container. The port may omit the protocol, which defaults to tcp.
+func PortBindings(ctx context.Context, container, port string) ([]HostBinding, error) {
+	port = normalizePort(port)
+	out, err := output(ctx, DockerPortCmd([]string{container, port}))
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := ParsePortOutput(string(out), port)
+	if err != nil {
+		return nil, err
+	}
+	return ports[port], nil
+}
+
+/*
+ParsePortOutput parses the output of 'docker port'. Lines have the form
+"80/tcp -> 0.0.0.0:49153" when no private port was given, or just
+"0.0.0.0:49153" when one was; port is used as the key for the latter.
+Both "[::]:49153" and the older ":::49153" IPv6 notations are accepted.
+*/
+func ParsePortOutput(out, port string) (map[string][]HostBinding, error) {
+	res := map[string][]HostBinding{}
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, addr := normalizePort(port), line
+		if k, a, ok := strings.Cut(line, " -> "); ok {
+			key, addr = k, a
+		}
+		if key == "" {
+			return nil, fmt.Errorf("parse port output %q: missing private port", line)
+		}
+
+		i := strings.LastIndex(addr, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("parse port output %q: missing host port", line)
+		}
+		n, err := strconv.Atoi(addr[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("parse port output %q: %w", line, err)
+		}
+		ip := strings.TrimSuffix(strings.TrimPrefix(addr[:i], "["), "]")
+
+		res[key] = append(res[key], newHostBinding(ip, n))
+	}
+	return res, nil
+}
+
+func normalizePort(port string) string {
+	if port != "" && !strings.Contains(port, "/") {
+		port += "/tcp"
+	}
+	return port
+}
@@ -0,0 +1,72 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePortOutput(t *testing.T) {
+	tests := []struct {
+		out     string
+		port    string
+		want    map[string][]HostBinding
+		wantErr bool
+	}{
+		{
+			out: "80/tcp -> 0.0.0.0:49153\n80/tcp -> [::]:49153\n53/udp -> 127.0.0.1:5353\n",
+			want: map[string][]HostBinding{
+				"80/tcp": {{IP: "0.0.0.0", Port: 49153, Family: IPv4}, {IP: "::", Port: 49153, Family: IPv6}},
+				"53/udp": {{IP: "127.0.0.1", Port: 5353, Family: IPv4}},
+			},
+		},
+		{
+			out:  "0.0.0.0:8080\n:::8080\n",
+			port: "80",
+			want: map[string][]HostBinding{
+				"80/tcp": {{IP: "0.0.0.0", Port: 8080, Family: IPv4}, {IP: "::", Port: 8080, Family: IPv6}},
+			},
+		},
+		{out: "", want: map[string][]HostBinding{}},
+		{out: "0.0.0.0:8080", wantErr: true},
+		{out: "80/tcp -> 0.0.0.0", wantErr: true},
+		{out: "80/tcp -> 0.0.0.0:http", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePortOutput(tt.out, tt.port)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePortOutput(%q, %q) error = %v, wantErr %v", tt.out, tt.port, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePortOutput(%q, %q) = %+v, want %+v", tt.out, tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestLocalAddress(t *testing.T) {
+	tests := []struct {
+		bindings []HostBinding
+		want     string
+		wantErr  bool
+	}{
+		{bindings: []HostBinding{newHostBinding("0.0.0.0", 8080)}, want: "127.0.0.1:8080"},
+		{bindings: []HostBinding{newHostBinding("", 8080)}, want: "127.0.0.1:8080"},
+		{bindings: []HostBinding{newHostBinding("::", 8080)}, want: "[::1]:8080"},
+		{bindings: []HostBinding{newHostBinding("::", 8081), newHostBinding("0.0.0.0", 8080)}, want: "127.0.0.1:8080"},
+		{bindings: []HostBinding{newHostBinding("192.168.1.5", 8081), newHostBinding("::1", 8080)}, want: "[::1]:8080"},
+		{bindings: []HostBinding{newHostBinding("192.168.1.5", 8081)}, want: "192.168.1.5:8081"},
+		{wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := LocalAddress(tt.bindings)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("LocalAddress(%+v) error = %v, wantErr %v", tt.bindings, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("LocalAddress(%+v) = %q, want %q", tt.bindings, got, tt.want)
+		}
+	}
+}
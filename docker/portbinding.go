@@ -0,0 +1,290 @@
+package docker
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+/*
+PortBinding is a typed port publishing specification. It renders to the
+short syntax of 'docker run --publish' and 'docker run --expose', and to
+the long syntax of 'docker service create --publish'.
+
+A zero HostPort publishes on a random host port. The *End fields describe
+inclusive ranges and are zero for single ports.
+*/
+type PortBinding struct {
+	HostIP           string
+	HostPort         int
+	HostPortEnd      int
+	ContainerPort    int
+	ContainerPortEnd int
+	// Protocol is "tcp", "udp" or "sctp"; empty means tcp.
+	Protocol string
+	// Mode is "ingress" or "host" and only applies to services.
+	Mode string
+}
+
+/*
+ParsePortBinding parses either the short syntax
+"[ip:][hostPort[-end]:]containerPort[-end][/proto]", where an IPv6 address
+is enclosed in brackets, or the long syntax
+"mode=host,target=80,published=8080,protocol=udp".
+*/
+func ParsePortBinding(s string) (PortBinding, error) {
+	var p PortBinding
+	var err error
+	if strings.Contains(s, "=") {
+		p, err = parseLongPortBinding(s)
+	} else {
+		p, err = parseShortPortBinding(s)
+	}
+	if err != nil {
+		return PortBinding{}, fmt.Errorf("parse port binding %q: %w", s, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return PortBinding{}, fmt.Errorf("parse port binding %q: %w", s, err)
+	}
+
+	return p, nil
+}
+
+func parseShortPortBinding(s string) (PortBinding, error) {
+	var p PortBinding
+
+	rest, proto, ok := strings.Cut(s, "/")
+	if ok {
+		p.Protocol = proto
+	}
+
+	if strings.HasPrefix(rest, "[") {
+		i := strings.Index(rest, "]:")
+		if i < 0 {
+			return p, fmt.Errorf("unterminated IPv6 address")
+		}
+		p.HostIP = rest[1:i]
+		rest = rest[i+2:]
+	}
+
+	parts := strings.Split(rest, ":")
+	var err error
+	switch len(parts) {
+	case 1:
+	case 2:
+		p.HostPort, p.HostPortEnd, err = parsePortRange(parts[0], true)
+	case 3:
+		if p.HostIP != "" {
+			return p, fmt.Errorf("too many colons")
+		}
+		p.HostIP = parts[0]
+		p.HostPort, p.HostPortEnd, err = parsePortRange(parts[1], true)
+	default:
+		return p, fmt.Errorf("IPv6 host address must be enclosed in brackets")
+	}
+	if err != nil {
+		return p, err
+	}
+
+	p.ContainerPort, p.ContainerPortEnd, err = parsePortRange(parts[len(parts)-1], false)
+	return p, err
+}
+
+func parseLongPortBinding(s string) (PortBinding, error) {
+	var p PortBinding
+	for _, field := range strings.Split(s, ",") {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return p, fmt.Errorf("invalid field %q", field)
+		}
+
+		var err error
+		switch strings.ToLower(key) {
+		case "mode":
+			p.Mode = val
+		case "target":
+			p.ContainerPort, err = strconv.Atoi(val)
+		case "published":
+			p.HostPort, err = strconv.Atoi(val)
+		case "protocol":
+			p.Protocol = val
+		default:
+			return p, fmt.Errorf("unknown field %q", key)
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	return p, nil
+}
+
+func parsePortRange(s string, optional bool) (int, int, error) {
+	if s == "" && optional {
+		return 0, 0, nil
+	}
+
+	start, end, isRange := strings.Cut(s, "-")
+	first, err := strconv.Atoi(start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", s)
+	}
+	if !isRange {
+		return first, 0, nil
+	}
+
+	last, err := strconv.Atoi(end)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %q", s)
+	}
+	return first, last, nil
+}
+
+// Validate reports whether the binding can be passed to docker.
+func (p PortBinding) Validate() error {
+	if err := validatePortRange("container", p.ContainerPort, p.ContainerPortEnd); err != nil {
+		return err
+	}
+	if p.HostPort != 0 || p.HostPortEnd != 0 {
+		if err := validatePortRange("host", p.HostPort, p.HostPortEnd); err != nil {
+			return err
+		}
+	}
+
+	if p.ContainerPortEnd != 0 && p.HostPort != 0 && p.HostPortEnd-p.HostPort != p.ContainerPortEnd-p.ContainerPort {
+		return fmt.Errorf("host and container port ranges differ in size")
+	}
+
+	if p.HostIP != "" && net.ParseIP(p.HostIP) == nil {
+		return fmt.Errorf("invalid host IP %q", p.HostIP)
+	}
+
+	switch p.Protocol {
+	case "", "tcp", "udp", "sctp":
+	default:
+		return fmt.Errorf("invalid protocol %q", p.Protocol)
+	}
+
+	switch p.Mode {
+	case "", "ingress", "host":
+	default:
+		return fmt.Errorf("invalid publish mode %q", p.Mode)
+	}
+
+	return nil
+}
+
+func validatePortRange(name string, start, end int) error {
+	if start < 1 || start > 65535 {
+		return fmt.Errorf("%s port %d out of range", name, start)
+	}
+	if end != 0 && (end < start || end > 65535) {
+		return fmt.Errorf("invalid %s port range %d-%d", name, start, end)
+	}
+	return nil
+}
+
+func (p PortBinding) protocol() string {
+	if p.Protocol == "" {
+		return "tcp"
+	}
+	return p.Protocol
+}
+
+// String renders the short syntax used by 'docker run --publish'.
+func (p PortBinding) String() string {
+	s := ""
+	if p.HostIP != "" {
+		ip := p.HostIP
+		if strings.Contains(ip, ":") {
+			ip = "[" + ip + "]"
+		}
+		s += ip + ":"
+	}
+	if p.HostPort != 0 {
+		s += formatPortRange(p.HostPort, p.HostPortEnd)
+	}
+	if s != "" {
+		s += ":"
+	}
+	return s + p.Expose()
+}
+
+// Expose renders the container side, as used by 'docker run --expose'.
+func (p PortBinding) Expose() string {
+	return formatPortRange(p.ContainerPort, p.ContainerPortEnd) + "/" + p.protocol()
+}
+
+// Long renders the long syntax used by 'docker service create --publish'.
+func (p PortBinding) Long() string {
+	s := []string{"target=" + strconv.Itoa(p.ContainerPort)}
+	if p.HostPort != 0 {
+		s = append(s, "published="+strconv.Itoa(p.HostPort))
+	}
+	s = append(s, "protocol="+p.protocol())
+	if p.Mode != "" {
+		s = append(s, "mode="+p.Mode)
+	}
+	return strings.Join(s, ",")
+}
+
+func formatPortRange(start, end int) string {
+	if end == 0 {
+		return strconv.Itoa(start)
+	}
+	return strconv.Itoa(start) + "-" + strconv.Itoa(end)
+}
+
+// PublishArgs validates bindings and renders them for
+// DockerRunOption.Publish and DockerCreateOption.Publish.
+func PublishArgs(bindings ...PortBinding) ([]string, error) {
+	return renderPortBindings(bindings, func(p PortBinding) (string, error) {
+		if p.Mode != "" {
+			return "", fmt.Errorf("publish mode is only supported by services")
+		}
+		return p.String(), nil
+	})
+}
+
+// ExposeArgs validates bindings and renders them for
+// DockerRunOption.Expose and DockerCreateOption.Expose.
+func ExposeArgs(bindings ...PortBinding) ([]string, error) {
+	return renderPortBindings(bindings, func(p PortBinding) (string, error) {
+		return p.Expose(), nil
+	})
+}
+
+// ServicePublishArg validates p and renders it in the long syntax for
+// DockerServiceCreateOption.Publish, which holds a single port. Services
+// accept neither host IPs nor port ranges.
+func ServicePublishArg(p PortBinding) (*string, error) {
+	res, err := renderPortBindings([]PortBinding{p}, func(p PortBinding) (string, error) {
+		if p.HostIP != "" {
+			return "", fmt.Errorf("host IP is not supported by services")
+		}
+		if p.HostPortEnd != 0 || p.ContainerPortEnd != 0 {
+			return "", fmt.Errorf("port ranges are not supported in the long syntax")
+		}
+		return p.Long(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+func renderPortBindings(bindings []PortBinding, render func(PortBinding) (string, error)) ([]string, error) {
+	var res []string
+	for _, p := range bindings {
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("port binding %s: %w", p, err)
+		}
+		s, err := render(p)
+		if err != nil {
+			return nil, fmt.Errorf("port binding %s: %w", p, err)
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePortBinding(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    PortBinding
+		short   string
+		wantErr bool
+	}{
+		{in: "80", want: PortBinding{ContainerPort: 80}, short: "80/tcp"},
+		{in: "8080:80", want: PortBinding{HostPort: 8080, ContainerPort: 80}, short: "8080:80/tcp"},
+		{in: "127.0.0.1:8080:80/udp", want: PortBinding{HostIP: "127.0.0.1", HostPort: 8080, ContainerPort: 80, Protocol: "udp"}, short: "127.0.0.1:8080:80/udp"},
+		{in: "127.0.0.1::80", want: PortBinding{HostIP: "127.0.0.1", ContainerPort: 80}, short: "127.0.0.1::80/tcp"},
+		{in: "[::1]:8080:80", want: PortBinding{HostIP: "::1", HostPort: 8080, ContainerPort: 80}, short: "[::1]:8080:80/tcp"},
+		{in: "8000-8010:9000-9010/sctp", want: PortBinding{HostPort: 8000, HostPortEnd: 8010, ContainerPort: 9000, ContainerPortEnd: 9010, Protocol: "sctp"}, short: "8000-8010:9000-9010/sctp"},
+		{in: "mode=host,target=80,published=8080,protocol=udp", want: PortBinding{HostPort: 8080, ContainerPort: 80, Protocol: "udp", Mode: "host"}, short: "8080:80/udp"},
+		{in: "", wantErr: true},
+		{in: "0", wantErr: true},
+		{in: "65536", wantErr: true},
+		{in: "8080:80/icmp", wantErr: true},
+		{in: "8000-8010:9000-9005", wantErr: true},
+		{in: "9010-9000", wantErr: true},
+		{in: "::1:8080:80", wantErr: true},
+		{in: "[::1:8080:80", wantErr: true},
+		{in: "999.0.0.1:8080:80", wantErr: true},
+		{in: "target=80,color=red", wantErr: true},
+		{in: "target=80,mode=global", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePortBinding(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePortBinding(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePortBinding(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if s := got.String(); s != tt.short {
+			t.Errorf("ParsePortBinding(%q).String() = %q, want %q", tt.in, s, tt.short)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LayerState is the state of a layer reported by 'docker pull' or
+// 'docker push'.
+type LayerState string
+
+const (
+	LayerWaiting           LayerState = "Waiting"
+	LayerPullingFSLayer    LayerState = "Pulling fs layer"
+	LayerDownloading       LayerState = "Downloading"
+	LayerVerifyingChecksum LayerState = "Verifying Checksum"
+	LayerDownloadComplete  LayerState = "Download complete"
+	LayerExtracting        LayerState = "Extracting"
+	LayerPullComplete      LayerState = "Pull complete"
+	LayerAlreadyExists     LayerState = "Already exists"
+	LayerPreparing         LayerState = "Preparing"
+	LayerPushing           LayerState = "Pushing"
+	LayerPushed            LayerState = "Pushed"
+	LayerExists            LayerState = "Layer already exists"
+	LayerMounted           LayerState = "Mounted from"
+	LayerRetrying          LayerState = "Retrying"
+)
+
+var layerStates = []LayerState{
+	LayerWaiting, LayerPullingFSLayer, LayerDownloading, LayerVerifyingChecksum,
+	LayerDownloadComplete, LayerExtracting, LayerPullComplete, LayerAlreadyExists,
+	LayerPreparing, LayerPushing, LayerPushed, LayerExists, LayerMounted, LayerRetrying,
+}
+
+// ProgressEvent is a line of 'docker pull' or 'docker push' output. Layer
+// is empty for lines that are not about a single layer, in which case only
+// Message is set.
+type ProgressEvent struct {
+	Layer   string
+	State   LayerState
+	Current int64
+	Total   int64
+	// From is the repository a layer was mounted from.
+	From    string
+	Message string
+}
+
+// ProgressResult is the summary at the end of a pull or push.
+type ProgressResult struct {
+	Digest string
+	// Size is the manifest size reported by 'docker push'.
+	Size   int64
+	Status string
+}
+
+var (
+	ansiRegexp       = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+	layerLineRegexp  = regexp.MustCompile(`^([0-9a-f]{12,64}): (.*)$`)
+	progressRegexp   = regexp.MustCompile(`([0-9.]+\s*[a-zA-Z]*B)/([0-9.]+\s*[a-zA-Z]*B)\s*$`)
+	pullDigestRegexp = regexp.MustCompile(`^Digest: (\S+)`)
+	pushDigestRegexp = regexp.MustCompile(`digest: (\S+) size: (\d+)`)
+	statusRegexp     = regexp.MustCompile(`^Status: (.*)$`)
+	retryingRegexp   = regexp.MustCompile(`^Retrying in \d+ seconds?`)
+)
+
+/*
+ParseProgress reads 'docker pull' or 'docker push' output from r, calls fn
+for every line, and returns the final digest and status. Both plain output
+and terminal output with progress bars and cursor movement are accepted.
+*/
+func ParseProgress(r io.Reader, fn func(ProgressEvent)) (ProgressResult, error) {
+	var res ProgressResult
+
+	sc := bufio.NewScanner(r)
+	sc.Split(scanProgressLines)
+	for sc.Scan() {
+		line := strings.TrimSpace(ansiRegexp.ReplaceAllString(sc.Text(), ""))
+		if line == "" {
+			continue
+		}
+
+		if m := pullDigestRegexp.FindStringSubmatch(line); m != nil {
+			res.Digest = m[1]
+		} else if m := pushDigestRegexp.FindStringSubmatch(line); m != nil {
+			res.Digest = m[1]
+			res.Size, _ = strconv.ParseInt(m[2], 10, 64)
+		} else if m := statusRegexp.FindStringSubmatch(line); m != nil {
+			res.Status = m[1]
+		}
+
+		if fn != nil {
+			fn(parseProgressLine(line))
+		}
+	}
+
+	return res, sc.Err()
+}
+
+func parseProgressLine(line string) ProgressEvent {
+	ev := ProgressEvent{Message: line}
+
+	m := layerLineRegexp.FindStringSubmatch(line)
+	if m == nil {
+		return ev
+	}
+	status := m[2]
+
+	for _, s := range layerStates {
+		if strings.HasPrefix(status, string(s)) {
+			ev.Layer = m[1]
+			ev.State = s
+			break
+		}
+	}
+	if ev.Layer == "" && retryingRegexp.MatchString(status) {
+		ev.Layer = m[1]
+		ev.State = LayerRetrying
+	}
+
+	switch ev.State {
+	case LayerMounted:
+		ev.From = strings.TrimSpace(strings.TrimPrefix(status, string(LayerMounted)))
+	case LayerDownloading, LayerExtracting, LayerPushing:
+		if p := progressRegexp.FindStringSubmatch(status); p != nil {
+			ev.Current, _ = parseSize(p[1])
+			ev.Total, _ = parseSize(p[2])
+		}
+	}
+
+	return ev
+}
+
+// scanProgressLines splits on both newlines and carriage returns, the
+// latter being used to redraw progress bars.
+func scanProgressLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// PullWithProgress pulls ref and reports its progress to fn. The byte
+// counts of the events are only reported on Linux, see runWithProgress.
+func PullWithProgress(ctx context.Context, opt DockerPullOption, ref Reference, fn func(ProgressEvent)) (ProgressResult, error) {
+	opt.Quiet = nil
+	return runWithProgress(ctx, DockerPullCmd(opt, []string{ref.String()}), fn)
+}
+
+// PushWithProgress pushes ref and reports its progress to fn. The result
+// carries the digest of the pushed manifest.
+func PushWithProgress(ctx context.Context, opt DockerPushOption, ref Reference, fn func(ProgressEvent)) (ProgressResult, error) {
+	opt.Quiet = nil
+	return runWithProgress(ctx, DockerPushCmd(opt, []string{ref.String()}), fn)
+}
+
+/*
+runWithProgress runs cmd with its output parsed by ParseProgress. Docker
+only prints progress bars, and so the Current and Total of Downloading,
+Extracting and Pushing events, when its output is a terminal; on Linux the
+output goes to a pty, elsewhere only state changes are reported.
+*/
+func runWithProgress(ctx context.Context, cmd *exec.Cmd, fn func(ProgressEvent)) (ProgressResult, error) {
+	c := withContext(ctx, cmd)
+	tail := &tailBuffer{max: 4096}
+	c.Stderr = tail
+
+	stdout, err := startTerminalOutput(c)
+	if err != nil {
+		return ProgressResult{}, cmdError(c, err, "")
+	}
+	defer stdout.Close()
+
+	res, perr := ParseProgress(stdout, fn)
+	io.Copy(io.Discard, stdout)
+
+	if err := c.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
+		return res, cmdError(c, err, tail.String())
+	}
+	return res, perr
+}
+
+// startPipeOutput starts c and returns its standard output.
+func startPipeOutput(c *exec.Cmd) (io.ReadCloser, error) {
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+	return stdout, nil
+}
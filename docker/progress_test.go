@@ -0,0 +1,123 @@
+package docker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseProgress(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   ProgressResult
+		events []ProgressEvent
+	}{
+		{
+			name: "plain pull",
+			output: "latest: Pulling from library/alpine\n" +
+				"8a49fdb3b6a5: Pulling fs layer\n" +
+				"8a49fdb3b6a5: Downloading  1.5MB/3.4MB\n" +
+				"8a49fdb3b6a5: Pull complete\n" +
+				"Digest: sha256:abc\n" +
+				"Status: Downloaded newer image for alpine:latest\n",
+			want: ProgressResult{Digest: "sha256:abc", Status: "Downloaded newer image for alpine:latest"},
+			events: []ProgressEvent{
+				{Message: "latest: Pulling from library/alpine"},
+				{Layer: "8a49fdb3b6a5", State: LayerPullingFSLayer, Message: "8a49fdb3b6a5: Pulling fs layer"},
+				{Layer: "8a49fdb3b6a5", State: LayerDownloading, Current: 1500000, Total: 3400000, Message: "8a49fdb3b6a5: Downloading  1.5MB/3.4MB"},
+				{Layer: "8a49fdb3b6a5", State: LayerPullComplete, Message: "8a49fdb3b6a5: Pull complete"},
+				{Message: "Digest: sha256:abc"},
+				{Message: "Status: Downloaded newer image for alpine:latest"},
+			},
+		},
+		{
+			name: "terminal pull",
+			output: "\x1b[1A\x1b[2K\r8a49fdb3b6a5: Extracting [=====>    ]  1.2kB/2.4kB\x1b[1B" +
+				"\r8a49fdb3b6a5: Retrying in 5 seconds\r\n",
+			events: []ProgressEvent{
+				{Layer: "8a49fdb3b6a5", State: LayerExtracting, Current: 1200, Total: 2400, Message: "8a49fdb3b6a5: Extracting [=====>    ]  1.2kB/2.4kB"},
+				{Layer: "8a49fdb3b6a5", State: LayerRetrying, Message: "8a49fdb3b6a5: Retrying in 5 seconds"},
+			},
+		},
+		{
+			name: "push",
+			output: "The push refers to repository [example.com/app]\n" +
+				"5f70bf18a086: Mounted from library/alpine\n" +
+				"e2eb06d8af82: Layer already exists\n" +
+				"v1: digest: sha256:def size: 528\n",
+			want: ProgressResult{Digest: "sha256:def", Size: 528},
+			events: []ProgressEvent{
+				{Message: "The push refers to repository [example.com/app]"},
+				{Layer: "5f70bf18a086", State: LayerMounted, From: "library/alpine", Message: "5f70bf18a086: Mounted from library/alpine"},
+				{Layer: "e2eb06d8af82", State: LayerExists, Message: "e2eb06d8af82: Layer already exists"},
+				{Message: "v1: digest: sha256:def size: 528"},
+			},
+		},
+		{
+			name:   "unknown layer status",
+			output: "8a49fdb3b6a5: Frobnicating\n",
+			events: []ProgressEvent{{Message: "8a49fdb3b6a5: Frobnicating"}},
+		},
+	}
+
+	for _, tt := range tests {
+		var events []ProgressEvent
+		got, err := ParseProgress(strings.NewReader(tt.output), func(ev ProgressEvent) {
+			events = append(events, ev)
+		})
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: result = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if !reflect.DeepEqual(events, tt.events) {
+			t.Errorf("%s: events = %+v, want %+v", tt.name, events, tt.events)
+		}
+	}
+}
+
+// fakeDocker installs a docker on PATH running the shell script body.
+func fakeDocker(t *testing.T, body string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a shell")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "docker"), []byte("#!/bin/sh\n"+body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestPullWithProgressTerminal(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("progress bars need a pty")
+	}
+	// Like docker, print progress bars only to a terminal.
+	fakeDocker(t, `[ -t 1 ] || { echo "not a terminal" >&2; exit 1; }
+printf '8a49fdb3b6a5: Downloading [==>   ]  1.5MB/3.4MB\r'
+echo '8a49fdb3b6a5: Pull complete'
+echo 'Digest: sha256:abc'
+`)
+
+	var events []ProgressEvent
+	res, err := PullWithProgress(context.Background(), DockerPullOption{}, MustParseReference("alpine"), func(ev ProgressEvent) {
+		events = append(events, ev)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Digest != "sha256:abc" {
+		t.Errorf("Digest = %q, want sha256:abc", res.Digest)
+	}
+	if len(events) != 3 || events[0].State != LayerDownloading || events[0].Current != 1500000 || events[0].Total != 3400000 {
+		t.Errorf("events = %+v, want a Downloading event with its byte counts first", events)
+	}
+}
@@ -0,0 +1,145 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// PruneReport is what a prune command removed, parsed from its output.
+type PruneReport struct {
+	Containers []string
+
+	// Images are the IDs of the deleted images and Untagged the references
+	// removed from images, deleted or not.
+	Images   []string
+	Untagged []string
+
+	Volumes    []string
+	Networks   []string
+	BuildCache []string
+
+	// Reclaimed is the reclaimed space in bytes, as rounded by docker to
+	// three significant digits.
+	Reclaimed int64
+}
+
+/*
+ParsePruneReport parses the output of the prune commands, e.g.
+
+	Deleted Images:
+	untagged: alpine:3.14
+	deleted: sha256:0a97eee8041e2b6c0e65abb2700b0705d0da5525ca69060b9e0bde8a3d17afdb
+
+	Total reclaimed space: 5.6MB
+
+The "Untagged:" and "Deleted:" lines of `docker image rm` are understood as
+well.
+*/
+func ParsePruneReport(data []byte) (*PruneReport, error) {
+	r := &PruneReport{}
+	var section *[]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "Total reclaimed space:") {
+			n, err := parseSize(strings.TrimPrefix(line, "Total reclaimed space:"))
+			if err != nil {
+				return nil, err
+			}
+			r.Reclaimed = n
+			continue
+		}
+
+		switch line {
+		case "Deleted Containers:":
+			section = &r.Containers
+		case "Deleted Images:":
+			section = &r.Images
+		case "Deleted Volumes:":
+			section = &r.Volumes
+		case "Deleted Networks:":
+			section = &r.Networks
+		case "Deleted build cache objects:":
+			section = &r.BuildCache
+		default:
+			kind, value, _ := strings.Cut(line, ": ")
+			switch {
+			case section != nil && section != &r.Images:
+				*section = append(*section, line)
+			case strings.EqualFold(kind, "untagged"):
+				r.Untagged = append(r.Untagged, value)
+			case strings.EqualFold(kind, "deleted"):
+				r.Images = append(r.Images, value)
+			default:
+				return nil, fmt.Errorf("unexpected prune output %q", line)
+			}
+		}
+	}
+	return r, scanner.Err()
+}
+
+// PruneContainers runs `docker container prune`. Force is always set, as
+// there is no terminal to confirm on.
+func PruneContainers(ctx context.Context, opt DockerContainerPruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerContainerPruneCmd(opt, nil))
+}
+
+// PruneImages runs `docker image prune` with Force set.
+func PruneImages(ctx context.Context, opt DockerImagePruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerImagePruneCmd(opt, nil))
+}
+
+// PruneVolumes runs `docker volume prune` with Force set.
+func PruneVolumes(ctx context.Context, opt DockerVolumePruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerVolumePruneCmd(opt, nil))
+}
+
+// PruneNetworks runs `docker network prune` with Force set. Networks take
+// no space, so Reclaimed is always zero.
+func PruneNetworks(ctx context.Context, opt DockerNetworkPruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerNetworkPruneCmd(opt, nil))
+}
+
+// PruneBuildCache runs `docker builder prune` with Force set.
+func PruneBuildCache(ctx context.Context, opt DockerBuilderPruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerBuilderPruneCmd(opt, nil))
+}
+
+// PruneSystem runs `docker system prune` with Force set.
+func PruneSystem(ctx context.Context, opt DockerSystemPruneOption) (*PruneReport, error) {
+	opt.Force = ptr(true)
+	return prune(ctx, DockerSystemPruneCmd(opt, nil))
+}
+
+func prune(ctx context.Context, cmd *exec.Cmd) (*PruneReport, error) {
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePruneReport(out)
+}
+
+// Add adds the removed objects and reclaimed space of other to r.
+func (r *PruneReport) Add(other *PruneReport) {
+	r.Containers = append(r.Containers, other.Containers...)
+	r.Images = append(r.Images, other.Images...)
+	r.Untagged = append(r.Untagged, other.Untagged...)
+	r.Volumes = append(r.Volumes, other.Volumes...)
+	r.Networks = append(r.Networks, other.Networks...)
+	r.BuildCache = append(r.BuildCache, other.BuildCache...)
+	r.Reclaimed += other.Reclaimed
+}
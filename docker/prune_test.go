@@ -0,0 +1,121 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePruneReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    *PruneReport
+		wantErr bool
+	}{
+		{
+			name:   "nothing removed",
+			output: "Total reclaimed space: 0B\n",
+			want:   &PruneReport{},
+		},
+		{
+			name: "containers",
+			output: "Deleted Containers:\n" +
+				"4a7f7eebae0f63178aff7eb0aa39cd3f0627a203ab2df258c1a00b456cf20063\n" +
+				"f98f9c2aa1eaf727e4ec9c0283bc7d4aa4762fbdba7f26191f26c97f64090360\n" +
+				"\n" +
+				"Total reclaimed space: 212 B\n",
+			want: &PruneReport{
+				Containers: []string{
+					"4a7f7eebae0f63178aff7eb0aa39cd3f0627a203ab2df258c1a00b456cf20063",
+					"f98f9c2aa1eaf727e4ec9c0283bc7d4aa4762fbdba7f26191f26c97f64090360",
+				},
+				Reclaimed: 212,
+			},
+		},
+		{
+			name: "images",
+			output: "Deleted Images:\n" +
+				"untagged: alpine:3.14\n" +
+				"untagged: alpine@sha256:06b5d462c92fc39303e6363c65e074559f8d6b1363250027ed5053557e3398c5\n" +
+				"deleted: sha256:0a97eee8041e2b6c0e65abb2700b0705d0da5525ca69060b9e0bde8a3d17afdb\n" +
+				"deleted: sha256:72e830a4dff5f0d5225cdc0a320e85ab1ce06ea5673acfe8d83a7645cbd0e9cf\n" +
+				"\n" +
+				"Total reclaimed space: 5.6MB\n",
+			want: &PruneReport{
+				Images: []string{
+					"sha256:0a97eee8041e2b6c0e65abb2700b0705d0da5525ca69060b9e0bde8a3d17afdb",
+					"sha256:72e830a4dff5f0d5225cdc0a320e85ab1ce06ea5673acfe8d83a7645cbd0e9cf",
+				},
+				Untagged: []string{
+					"alpine:3.14",
+					"alpine@sha256:06b5d462c92fc39303e6363c65e074559f8d6b1363250027ed5053557e3398c5",
+				},
+				Reclaimed: 5600000,
+			},
+		},
+		{
+			name:   "image rm",
+			output: "Untagged: app:v1\nDeleted: sha256:a1\n",
+			want:   &PruneReport{Images: []string{"sha256:a1"}, Untagged: []string{"app:v1"}},
+		},
+		{
+			name: "system",
+			output: "Deleted Containers:\n" +
+				"c1\n" +
+				"\n" +
+				"Deleted Networks:\n" +
+				"app_default\n" +
+				"\n" +
+				"Deleted Volumes:\n" +
+				"data\n" +
+				"\n" +
+				"Deleted Images:\n" +
+				"deleted: sha256:d1\n" +
+				"\n" +
+				"Deleted build cache objects:\n" +
+				"b1\n" +
+				"b2\n" +
+				"\n" +
+				"Total reclaimed space: 1.5GB\n",
+			want: &PruneReport{
+				Containers: []string{"c1"},
+				Networks:   []string{"app_default"},
+				Volumes:    []string{"data"},
+				Images:     []string{"sha256:d1"},
+				BuildCache: []string{"b1", "b2"},
+				Reclaimed:  1500000000,
+			},
+		},
+		{
+			name:    "unexpected line",
+			output:  "WARNING! This will remove all stopped containers.\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid size",
+			output:  "Total reclaimed space: lots\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePruneReport([]byte(tt.output))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: report = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPruneReportAdd(t *testing.T) {
+	r := &PruneReport{Containers: []string{"c1"}, Reclaimed: 10}
+	r.Add(&PruneReport{Containers: []string{"c2"}, Volumes: []string{"v1"}, Reclaimed: 5})
+
+	want := &PruneReport{Containers: []string{"c1", "c2"}, Volumes: []string{"v1"}, Reclaimed: 15}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Add = %+v, want %+v", r, want)
+	}
+}
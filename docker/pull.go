@@ -0,0 +1,207 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PullErrorKind classifies a failed pull.
+type PullErrorKind int
+
+const (
+	// PullErrorRetryable is a transient failure, e.g. a network timeout.
+	PullErrorRetryable PullErrorKind = iota
+	// PullErrorRateLimited is Docker Hub's "toomanyrequests".
+	PullErrorRateLimited
+	// PullErrorPermanent will not go away by retrying, e.g. an unknown
+	// manifest or missing credentials.
+	PullErrorPermanent
+)
+
+func (k PullErrorKind) String() string {
+	switch k {
+	case PullErrorRetryable:
+		return "retryable"
+	case PullErrorRateLimited:
+		return "rate limited"
+	default:
+		return "permanent"
+	}
+}
+
+var (
+	rateLimitMessages = []string{
+		"toomanyrequests",
+		"too many requests",
+		"rate limit",
+	}
+	// permanentPullMessages are the registry error codes of the
+	// distribution spec and docker's own messages for pulls that cannot
+	// succeed. Broader phrases such as "not found" also appear in
+	// transient errors, e.g. from proxies.
+	permanentPullMessages = []string{
+		"manifest unknown",
+		"name unknown",
+		"name invalid",
+		"pull access denied",
+		"unauthorized:",
+		"denied:",
+		"invalid reference format",
+		"no matching manifest",
+		"unknown flag",
+	}
+)
+
+// ClassifyPullError tells whether a pull that failed with err is worth
+// retrying. Cancellation and failures to start docker are permanent, other
+// errors not recognised as permanent are considered retryable.
+func ClassifyPullError(err error) PullErrorKind {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return PullErrorPermanent
+	}
+	var execErr *exec.Error
+	if errors.As(err, &execErr) {
+		return PullErrorPermanent
+	}
+
+	msg := strings.ToLower(err.Error())
+	var cmdErr *CmdError
+	if errors.As(err, &cmdErr) {
+		var exitErr *exec.ExitError
+		if !errors.As(cmdErr.Err, &exitErr) {
+			// docker did not run at all.
+			return PullErrorPermanent
+		}
+		if strings.TrimSpace(cmdErr.Stderr) != "" {
+			msg = strings.ToLower(cmdErr.Stderr)
+		}
+	}
+
+	for _, m := range rateLimitMessages {
+		if strings.Contains(msg, m) {
+			return PullErrorRateLimited
+		}
+	}
+	for _, m := range permanentPullMessages {
+		if strings.Contains(msg, m) {
+			return PullErrorPermanent
+		}
+	}
+	return PullErrorRetryable
+}
+
+/*
+PullRetry configures PullWithRetry and PullImages. Zero values select the
+defaults: 3 attempts, backoff doubling from 1s up to 30s, at least 30s of
+backoff after a rate limit, and 4 concurrent pulls.
+*/
+type PullRetry struct {
+	Attempts         int
+	InitialBackoff   time.Duration
+	MaxBackoff       time.Duration
+	RateLimitBackoff time.Duration
+	Concurrency      int
+}
+
+func (r PullRetry) withDefaults() PullRetry {
+	if r.Attempts <= 0 {
+		r.Attempts = 3
+	}
+	if r.InitialBackoff <= 0 {
+		r.InitialBackoff = time.Second
+	}
+	if r.MaxBackoff <= 0 {
+		r.MaxBackoff = 30 * time.Second
+	}
+	if r.RateLimitBackoff <= 0 {
+		r.RateLimitBackoff = 30 * time.Second
+	}
+	if r.Concurrency <= 0 {
+		r.Concurrency = 4
+	}
+	return r
+}
+
+// PullResult is the outcome of pulling one image.
+type PullResult struct {
+	Ref      Reference
+	Attempts int
+	Duration time.Duration
+	// Kind classifies Err and is meaningless when Err is nil.
+	Kind PullErrorKind
+	Err  error
+}
+
+// PullWithRetry pulls ref, retrying retryable and rate-limited failures
+// with exponential backoff.
+func PullWithRetry(ctx context.Context, opt DockerPullOption, ref Reference, retry PullRetry) PullResult {
+	retry = retry.withDefaults()
+	res := PullResult{Ref: ref}
+	start := time.Now()
+	backoff := retry.InitialBackoff
+
+	for {
+		res.Attempts++
+		res.Err = PullImage(ctx, opt, ref)
+		if res.Err == nil {
+			break
+		}
+
+		res.Kind = ClassifyPullError(res.Err)
+		if res.Kind == PullErrorPermanent || res.Attempts >= retry.Attempts {
+			break
+		}
+
+		wait := backoff
+		if res.Kind == PullErrorRateLimited && wait < retry.RateLimitBackoff {
+			wait = retry.RateLimitBackoff
+		}
+		wait += time.Duration(rand.Int63n(int64(wait)/10 + 1))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			res.Err = ctx.Err()
+			res.Kind = PullErrorPermanent
+			res.Duration = time.Since(start)
+			return res
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > retry.MaxBackoff {
+			backoff = retry.MaxBackoff
+		}
+	}
+
+	res.Duration = time.Since(start)
+	return res
+}
+
+// PullImages pulls refs with at most retry.Concurrency pulls at a time
+// and returns one result per reference, in the same order.
+func PullImages(ctx context.Context, opt DockerPullOption, refs []Reference, retry PullRetry) []PullResult {
+	retry = retry.withDefaults()
+	results := make([]PullResult, len(refs))
+	sem := make(chan struct{}, retry.Concurrency)
+
+	var wg sync.WaitGroup
+	for i, ref := range refs {
+		wg.Add(1)
+		go func(i int, ref Reference) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = PullWithRetry(ctx, opt, ref, retry)
+		}(i, ref)
+	}
+	wg.Wait()
+
+	return results
+}
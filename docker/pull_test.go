@@ -0,0 +1,49 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"testing"
+)
+
+func TestClassifyPullError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a shell")
+	}
+	exitErr := exec.Command("sh", "-c", "exit 1").Run()
+	failed := func(stderr string) error {
+		return &CmdError{Args: []string{"docker", "pull", "app"}, ExitCode: 1, Stderr: stderr, Err: exitErr}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want PullErrorKind
+	}{
+		{"canceled", context.Canceled, PullErrorPermanent},
+		{"deadline", fmt.Errorf("pull: %w", context.DeadlineExceeded), PullErrorPermanent},
+		{"no docker", &exec.Error{Name: "docker", Err: exec.ErrNotFound}, PullErrorPermanent},
+		{"not started", &CmdError{Err: errors.New("fork/exec: resource temporarily unavailable")}, PullErrorPermanent},
+		{"manifest unknown", failed("Error response from daemon: manifest for app:nope not found: manifest unknown: manifest unknown"), PullErrorPermanent},
+		{"name unknown", failed("Error response from daemon: name unknown: repository name not known to registry"), PullErrorPermanent},
+		{"pull access denied", failed("Error response from daemon: pull access denied for app, repository does not exist or may require 'docker login': denied: requested access to the resource is denied"), PullErrorPermanent},
+		{"unauthorized", failed("Error response from daemon: Head \"https://registry.example.com/v2/app/manifests/latest\": unauthorized: authentication required"), PullErrorPermanent},
+		{"bad reference", failed("invalid reference format"), PullErrorPermanent},
+		{"platform", failed("no matching manifest for linux/arm64/v8 in the manifest list entries"), PullErrorPermanent},
+		{"rate limited", failed("Error response from daemon: toomanyrequests: You have reached your pull rate limit."), PullErrorRateLimited},
+		{"proxy 404", failed("Error response from daemon: Get \"https://registry.example.com/v2/\": 404 Not Found"), PullErrorRetryable},
+		{"dns", failed("Error response from daemon: Get \"https://registry-1.docker.io/v2/\": dial tcp: lookup registry-1.docker.io: no such host"), PullErrorRetryable},
+		{"timeout", failed("Error response from daemon: Get \"https://registry-1.docker.io/v2/\": net/http: request canceled while waiting for connection (Client.Timeout exceeded while awaiting headers)"), PullErrorRetryable},
+		{"reset", failed("error pulling image configuration: read tcp 10.0.0.2:53124->104.18.121.25:443: read: connection reset by peer"), PullErrorRetryable},
+		{"no stderr", failed(""), PullErrorRetryable},
+	}
+
+	for _, tt := range tests {
+		if got := ClassifyPullError(tt.err); got != tt.want {
+			t.Errorf("%s: ClassifyPullError = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+)
+
+const redacted = "*****"
+
+// Flags whose whole value is secret.
+var secretFlags = map[string]bool{
+	"--password": true,
+	"--token":    true,
+}
+
+// Flags taking NAME=VALUE pairs whose value is secret when NAME matches one
+// of the sensitive name patterns.
+var keyValueFlags = map[string]bool{
+	"--env":       true,
+	"--env-add":   true,
+	"--build-arg": true,
+}
+
+// keyValueShorthand is the shorthand of --env.
+const keyValueShorthand = 'e'
+
+var (
+	sensitiveMu       sync.RWMutex
+	sensitivePatterns = []string{
+		"*PASSWORD*",
+		"*PASSWD*",
+		"*SECRET*",
+		"*TOKEN*",
+		"*_KEY",
+		"*APIKEY*",
+		"*API_KEY*",
+		"*CREDENTIAL*",
+		"*PRIVATE*",
+	}
+)
+
+// AddSensitivePattern registers a glob pattern, e.g. "*_TOKEN", matched
+// case-insensitively against env and build-arg names whose values are
+// redacted.
+func AddSensitivePattern(pattern string) {
+	sensitiveMu.Lock()
+	defer sensitiveMu.Unlock()
+	sensitivePatterns = append(sensitivePatterns, strings.ToUpper(pattern))
+}
+
+// IsSensitiveName reports whether the value of the env or build-arg
+// variable name is redacted.
+func IsSensitiveName(name string) bool {
+	name = strings.ToUpper(name)
+
+	sensitiveMu.RLock()
+	defer sensitiveMu.RUnlock()
+	for _, p := range sensitivePatterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+RedactArgs returns a copy of args with secret values replaced by "*****":
+the values of --password and --token (and -p of 'docker login'), and the
+values of --env, -e and --build-arg entries whose names are sensitive.
+The "--flag value", "--flag=value", "-e value" and "-eNAME=VALUE" forms are
+handled, as are shorthands combined with boolean ones such as -ite.
+
+The docker command is recognized to tell the values of its flags from
+other arguments; the arguments of the program a container runs, as in
+'docker run IMAGE COMMAND', only have their -e and long secret flags
+redacted.
+*/
+func RedactArgs(args []string) []string {
+	res := append([]string{}, args...)
+
+	i := 0
+	if len(res) > 0 && isDockerBinary(res[0]) {
+		i = 1
+	}
+	// c is the command whose flags are being read, or nil once they are
+	// unknown. Positional arguments select subcommands until the first one
+	// that does not name a subcommand.
+	c := lookupCommand([]string{"docker"})
+	subcommands := true
+
+	for ; i < len(res); i++ {
+		arg := res[i]
+		switch {
+		case arg == "--":
+			c, subcommands = nil, false
+		case strings.HasPrefix(arg, "--"):
+			i = redactLongFlag(res, i, c)
+		case strings.HasPrefix(arg, "-") && arg != "-":
+			login := c != nil && strings.Join(c.Path, " ") == "docker login"
+			i = redactShortFlags(res, i, c, login)
+		default:
+			if subcommands && c != nil {
+				if sub := lookupSubcommand(c, arg); sub != nil {
+					c = sub
+					continue
+				}
+			}
+			subcommands = false
+			if c != nil && nonInterspersed[strings.Join(c.Path, " ")] {
+				c = nil
+			}
+		}
+	}
+
+	return res
+}
+
+// redactLongFlag redacts the value of the long flag at res[i] and returns
+// the index of the last argument the flag takes.
+func redactLongFlag(res []string, i int, c *CommandInfo) int {
+	flag, value, inline := strings.Cut(res[i], "=")
+	secret := secretFlags[flag]
+	if !secret && !keyValueFlags[flag] {
+		if f, ok := lookupFlag(c, flag[2:], ""); ok && !inline && f.Type != "bool" {
+			return i + 1
+		}
+		return i
+	}
+
+	if inline {
+		res[i] = flag + "=" + redactValue(value, secret)
+		return i
+	}
+	if i+1 < len(res) {
+		res[i+1] = redactValue(res[i+1], secret)
+	}
+	return i + 1
+}
+
+// redactShortFlags redacts the value of the shorthands at res[i], where
+// boolean shorthands may precede one taking a value, and returns the index
+// of the last argument they take.
+func redactShortFlags(res []string, i int, c *CommandInfo, login bool) int {
+	arg := res[i]
+	for j := 1; j < len(arg); j++ {
+		short, rest := arg[j], arg[j+1:]
+		secret := login && short == 'p'
+		if secret || short == keyValueShorthand {
+			if rest == "" {
+				if i+1 < len(res) {
+					res[i+1] = redactValue(res[i+1], secret)
+				}
+				return i + 1
+			}
+			prefix := arg[:j+1]
+			if strings.HasPrefix(rest, "=") {
+				prefix, rest = prefix+"=", rest[1:]
+			}
+			res[i] = prefix + redactValue(rest, secret)
+			return i
+		}
+
+		f, ok := lookupFlag(c, "", string(short))
+		if !ok {
+			return i
+		}
+		if f.Type != "bool" {
+			if rest == "" {
+				return i + 1
+			}
+			return i
+		}
+	}
+	return i
+}
+
+// lookupFlag returns the flag of c with the long name or shorthand, if c
+// is known.
+func lookupFlag(c *CommandInfo, name, shorthand string) (FlagInfo, bool) {
+	switch {
+	case c == nil:
+		return FlagInfo{}, false
+	case shorthand != "":
+		return c.ShorthandFlag(shorthand)
+	default:
+		return c.Flag(name)
+	}
+}
+
+func redactValue(value string, secret bool) string {
+	if secret {
+		return redacted
+	}
+	return redactPair(value)
+}
+
+func redactPair(pair string) string {
+	name, _, ok := strings.Cut(pair, "=")
+	if ok && IsSensitiveName(name) {
+		return name + "=" + redacted
+	}
+	return pair
+}
+
+// Redact renders cmd like cmd.String() with secret values redacted. Use it
+// instead of printing the command directly.
+func Redact(cmd *exec.Cmd) string {
+	return strings.Join(RedactArgs(cmd.Args), " ")
+}
+
+// CreateSecret creates a swarm secret with 'docker secret create NAME -',
+// reading its content from data so that it never appears in the command
+// line. It returns the ID of the secret.
+func CreateSecret(ctx context.Context, opt DockerSecretCreateOption, name string, data io.Reader) (string, error) {
+	cmd := DockerSecretCreateCmd(opt, []string{name, "-"})
+	cmd.Stdin = data
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+	return lastLine(out), nil
+}
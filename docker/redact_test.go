@@ -0,0 +1,46 @@
+package docker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactArgs(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"docker login -u me -p hunter2 registry", "docker login -u me -p ***** registry"},
+		{"docker login -phunter2 registry", "docker login -p***** registry"},
+		{"docker login -p=hunter2", "docker login -p=*****"},
+		{"docker -H tcp://host login --password hunter2", "docker -H tcp://host login --password *****"},
+		{"docker login --password=hunter2", "docker login --password=*****"},
+		{"docker run img login -p 8080", "docker run img login -p 8080"},
+		{"docker run -p 8080:80 img", "docker run -p 8080:80 img"},
+		{"docker run -e AWS_SECRET=1 -e HOME=/root img", "docker run -e AWS_SECRET=***** -e HOME=/root img"},
+		{"docker run -eAWS_SECRET=1 img", "docker run -eAWS_SECRET=***** img"},
+		{"docker run -e=AWS_SECRET=1 img", "docker run -e=AWS_SECRET=***** img"},
+		{"docker run -ite AWS_SECRET=1 img", "docker run -ite AWS_SECRET=***** img"},
+		{"docker run -iteAWS_SECRET=1 img", "docker run -iteAWS_SECRET=***** img"},
+		{"docker run --env=DB_PASSWORD=x --env USER=x img", "docker run --env=DB_PASSWORD=***** --env USER=x img"},
+		{"docker run -w /secret -u root img", "docker run -w /secret -u root img"},
+		{"docker run -wsecret img", "docker run -wsecret img"},
+		{"docker run img sh -c env -eAPI_TOKEN=x", "docker run img sh -c env -eAPI_TOKEN=*****"},
+		{"docker build --build-arg NPM_TOKEN=x .", "docker build --build-arg NPM_TOKEN=***** ."},
+		{"docker service update --env-add GH_TOKEN=x web", "docker service update --env-add GH_TOKEN=***** web"},
+		{"docker swarm join --token SWMTKN-1-x host:2377", "docker swarm join --token ***** host:2377"},
+		{"docker login -p", "docker login -p"},
+	}
+
+	for _, tt := range tests {
+		args := strings.Fields(tt.line)
+		orig := append([]string(nil), args...)
+		if got := strings.Join(RedactArgs(args), " "); got != tt.want {
+			t.Errorf("RedactArgs(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+		if !reflect.DeepEqual(args, orig) {
+			t.Errorf("RedactArgs(%q) modified its argument", tt.line)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	defaultDomain = "docker.io"
+	officialRepo  = "library"
+	defaultTag    = "latest"
+	maxNameLength = 255
+)
+
+var (
+	domainComponent = `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])`
+	domainRegexp    = regexp.MustCompile(`^(?:` + domainComponent + `(?:\.` + domainComponent + `)*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?$`)
+	pathRegexp      = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*)*$`)
+	tagRegexp       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRegexp    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}$`)
+)
+
+/*
+Reference is a normalised image reference of the form
+domain/path[:tag][@digest]. Docker Hub shorthands are expanded, so "alpine"
+becomes docker.io/library/alpine:latest.
+*/
+type Reference struct {
+	Domain string
+	Path   string
+	Tag    string
+	Digest string
+}
+
+// ParseReference parses and normalises s following the grammar of the
+// distribution reference format.
+func ParseReference(s string) (Reference, error) {
+	var ref Reference
+	rest := s
+
+	if i := strings.Index(rest, "@"); i >= 0 {
+		ref.Digest = rest[i+1:]
+		rest = rest[:i]
+		if !digestRegexp.MatchString(ref.Digest) {
+			return Reference{}, fmt.Errorf("invalid reference %q: invalid digest", s)
+		}
+	}
+
+	if i := strings.LastIndex(rest, ":"); i >= 0 && !strings.Contains(rest[i+1:], "/") {
+		ref.Tag = rest[i+1:]
+		rest = rest[:i]
+		if !tagRegexp.MatchString(ref.Tag) {
+			return Reference{}, fmt.Errorf("invalid reference %q: invalid tag", s)
+		}
+	}
+
+	ref.Domain, ref.Path = splitDomain(rest)
+	if ref.Domain == "" || !domainRegexp.MatchString(ref.Domain) {
+		return Reference{}, fmt.Errorf("invalid reference %q: invalid domain", s)
+	}
+	if !pathRegexp.MatchString(ref.Path) {
+		if strings.ToLower(ref.Path) == ref.Path {
+			return Reference{}, fmt.Errorf("invalid reference %q: invalid repository name", s)
+		}
+		return Reference{}, fmt.Errorf("invalid reference %q: repository name must be lowercase", s)
+	}
+	if len(ref.Name()) > maxNameLength {
+		return Reference{}, fmt.Errorf("invalid reference %q: repository name longer than %d characters", s, maxNameLength)
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	return ref, nil
+}
+
+// MustParseReference is like ParseReference but panics on error.
+func MustParseReference(s string) Reference {
+	ref, err := ParseReference(s)
+	if err != nil {
+		panic(err)
+	}
+	return ref
+}
+
+// splitDomain separates the registry from the repository path the same way
+// the docker CLI does: the first component is a registry only if it
+// contains a dot or a port, or is "localhost".
+func splitDomain(name string) (string, string) {
+	domain, path := defaultDomain, name
+
+	i := strings.Index(name, "/")
+	if i >= 0 {
+		first := name[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" || strings.ToLower(first) != first {
+			domain, path = first, name[i+1:]
+		}
+	}
+
+	if domain == "index.docker.io" {
+		domain = defaultDomain
+	}
+	if domain == defaultDomain && !strings.Contains(path, "/") {
+		path = officialRepo + "/" + path
+	}
+
+	return domain, path
+}
+
+// Name returns the repository name including the registry.
+func (r Reference) Name() string {
+	return r.Domain + "/" + r.Path
+}
+
+// String returns the fully qualified reference.
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// Familiar returns the shortest form docker accepts for the reference,
+// e.g. "alpine:latest" for docker.io/library/alpine:latest.
+func (r Reference) Familiar() string {
+	name := r.Name()
+	if r.Domain == defaultDomain {
+		name = strings.TrimPrefix(r.Path, officialRepo+"/")
+	}
+
+	if r.Tag != "" {
+		name += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		name += "@" + r.Digest
+	}
+	return name
+}
+
+// WithTag returns the reference with the given tag and no digest.
+func (r Reference) WithTag(tag string) Reference {
+	r.Tag = tag
+	r.Digest = ""
+	return r
+}
+
+// IsDockerHub reports whether the reference points to Docker Hub.
+func (r Reference) IsDockerHub() bool {
+	return r.Domain == defaultDomain
+}
+
+// ReferenceStrings renders refs for string fields such as
+// DockerBuildOption.Tag or ImagePatch.Tags.
+func ReferenceStrings(refs ...Reference) []string {
+	var res []string
+	for _, r := range refs {
+		res = append(res, r.String())
+	}
+	return res
+}
+
+// PullImage pulls ref with 'docker pull'.
+func PullImage(ctx context.Context, opt DockerPullOption, ref Reference) error {
+	_, err := output(ctx, DockerPullCmd(opt, []string{ref.String()}))
+	return err
+}
+
+// PushImage pushes ref with 'docker push'.
+func PushImage(ctx context.Context, opt DockerPushOption, ref Reference) error {
+	_, err := output(ctx, DockerPushCmd(opt, []string{ref.String()}))
+	return err
+}
+
+// TagImage creates the tag target referring to source with 'docker tag'.
+func TagImage(ctx context.Context, source, target Reference) error {
+	if target.Digest != "" {
+		return fmt.Errorf("tag %s: target must not contain a digest", target)
+	}
+	_, err := output(ctx, DockerTagCmd([]string{source.String(), target.String()}))
+	return err
+}
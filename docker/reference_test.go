@@ -0,0 +1,60 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	const digest = "sha256:0a97eee8041e2b6c0e65abb2700b0705d0da5525ca69060b9e0bde8a3d17afdb"
+	tests := []struct {
+		in       string
+		want     Reference
+		familiar string
+		wantErr  string
+	}{
+		{in: "alpine", want: Reference{Domain: "docker.io", Path: "library/alpine", Tag: "latest"}, familiar: "alpine:latest"},
+		{in: "alpine:3.14", want: Reference{Domain: "docker.io", Path: "library/alpine", Tag: "3.14"}, familiar: "alpine:3.14"},
+		{in: "user/app", want: Reference{Domain: "docker.io", Path: "user/app", Tag: "latest"}, familiar: "user/app:latest"},
+		{in: "index.docker.io/alpine", want: Reference{Domain: "docker.io", Path: "library/alpine", Tag: "latest"}, familiar: "alpine:latest"},
+		{in: "localhost/app", want: Reference{Domain: "localhost", Path: "app", Tag: "latest"}, familiar: "localhost/app:latest"},
+		{in: "localhost:5000/a/b:v1", want: Reference{Domain: "localhost:5000", Path: "a/b", Tag: "v1"}, familiar: "localhost:5000/a/b:v1"},
+		{in: "ghcr.io/org/app@" + digest, want: Reference{Domain: "ghcr.io", Path: "org/app", Digest: digest}, familiar: "ghcr.io/org/app@" + digest},
+		{in: "alpine:3.14@" + digest, want: Reference{Domain: "docker.io", Path: "library/alpine", Tag: "3.14", Digest: digest}, familiar: "alpine:3.14@" + digest},
+		{in: "[::1]:5000/app", want: Reference{Domain: "[::1]:5000", Path: "app", Tag: "latest"}, familiar: "[::1]:5000/app:latest"},
+		{in: "Registry/app", want: Reference{Domain: "Registry", Path: "app", Tag: "latest"}, familiar: "Registry/app:latest"},
+		{in: "my_app__x.y-z", want: Reference{Domain: "docker.io", Path: "library/my_app__x.y-z", Tag: "latest"}, familiar: "my_app__x.y-z:latest"},
+		{in: "", wantErr: "invalid repository name"},
+		{in: "Alpine", wantErr: "must be lowercase"},
+		{in: "alpine:", wantErr: "invalid tag"},
+		{in: "alpine:-x", wantErr: "invalid tag"},
+		{in: "alpine@sha256:abc", wantErr: "invalid digest"},
+		{in: "-bad.io/app", wantErr: "invalid domain"},
+		{in: "app/", wantErr: "invalid repository name"},
+		{in: "a//b", wantErr: "invalid repository name"},
+		{in: "docker.io/" + strings.Repeat("a", 255), wantErr: "longer than 255"},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseReference(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseReference(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseReference(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseReference(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if f := got.Familiar(); f != tt.familiar {
+			t.Errorf("ParseReference(%q).Familiar() = %q, want %q", tt.in, f, tt.familiar)
+		}
+		if again, err := ParseReference(got.String()); err != nil || again != got {
+			t.Errorf("ParseReference(%q) = %+v, %v, want %+v", got.String(), again, err, got)
+		}
+	}
+}
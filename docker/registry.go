@@ -0,0 +1,205 @@
+package docker
+
+import (
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+/*
+CommandInfo describes a docker command as defined by docker/cli, generated
+alongside its wrapper function. For `docker container ls`:
+
+	Name:    "DockerContainerLs"
+	Path:    []string{"docker", "container", "ls"}
+	Aliases: []string{"ps", "list"}
+*/
+type CommandInfo struct {
+	// Name is the generated identifier; Name + "Cmd" is the wrapper
+	// function and Name + "Option" its option struct.
+	Name string
+
+	// Path is the full command path starting with "docker".
+	Path []string
+
+	// Aliases are the alternative names of the last path element.
+	Aliases []string
+
+	// Use and Short are the usage line and description of the command.
+	Use   string
+	Short string
+
+	// Deprecated is the deprecation message of the command, or empty.
+	Deprecated string
+
+	// Hidden commands are not shown in docker's help output.
+	Hidden bool
+
+	Annotations Annotations
+
+	// Flags are the command's flags, sorted by name.
+	Flags []FlagInfo
+
+	option func() interface{}
+	cmd    func(opt interface{}, args []string) *exec.Cmd
+}
+
+// FlagInfo describes a flag of a command and the option field it maps to.
+type FlagInfo struct {
+	// Name is the long flag name without the leading "--".
+	Name string
+
+	// Shorthand is the one-letter flag name without the leading "-", or
+	// empty.
+	Shorthand string
+
+	// Field is the name of the field in the command's option struct.
+	Field string
+
+	// Type and Default are the flag type and default value as reported by
+	// docker, e.g. "list", "bytes" or "duration".
+	Type    string
+	Default string
+
+	Usage string
+
+	// Deprecated is the deprecation message of the flag, or empty.
+	Deprecated string
+
+	// Hidden flags are not shown in docker's help output.
+	Hidden bool
+
+	Annotations Annotations
+}
+
+// Annotations are the requirements docker/cli attaches to commands and
+// flags. A subcommand also has the requirements of its parents.
+type Annotations struct {
+	// MinAPIVersion is the lowest daemon API version supporting the command
+	// or flag, e.g. "1.40", or empty.
+	MinAPIVersion string
+
+	// OSType is the daemon operating system required, "linux" or
+	// "windows", or empty.
+	OSType string
+
+	// Experimental requires a daemon with experimental features enabled;
+	// ExperimentalCLI marks experimental features of the CLI itself.
+	Experimental    bool
+	ExperimentalCLI bool
+
+	// Swarm requires a daemon that is part of a swarm.
+	Swarm bool
+
+	// Kubernetes marks flags used with the Kubernetes stack orchestrator.
+	Kubernetes bool
+
+	// BuildKit flags need builds to use BuildKit; NoBuildKit flags are
+	// ignored by BuildKit.
+	BuildKit   bool
+	NoBuildKit bool
+}
+
+var (
+	commandsOnce     sync.Once
+	commandsByPath   map[string]*CommandInfo
+	commandsByName   map[string]*CommandInfo
+	commandsByParent map[string]map[string]*CommandInfo
+)
+
+func indexCommands() {
+	commandsOnce.Do(func() {
+		commandsByPath = map[string]*CommandInfo{}
+		commandsByName = map[string]*CommandInfo{}
+		commandsByParent = map[string]map[string]*CommandInfo{}
+
+		for i := range commands {
+			c := &commands[i]
+			commandsByPath[strings.Join(c.Path, " ")] = c
+			commandsByName[c.Name] = c
+			if len(c.Path) < 2 {
+				continue
+			}
+
+			parent := strings.Join(c.Path[:len(c.Path)-1], " ")
+			if commandsByParent[parent] == nil {
+				commandsByParent[parent] = map[string]*CommandInfo{}
+			}
+			for _, name := range append([]string{c.Path[len(c.Path)-1]}, c.Aliases...) {
+				commandsByParent[parent][name] = c
+			}
+		}
+	})
+}
+
+// Commands returns the metadata of every generated command.
+func Commands() []CommandInfo {
+	return append([]CommandInfo(nil), commands...)
+}
+
+// LookupCommand returns the command at path, which starts with "docker".
+// Aliases are resolved as docker does, so both ("docker", "container", "ps")
+// and ("docker", "container", "ls") return DockerContainerLs.
+func LookupCommand(path ...string) (CommandInfo, bool) {
+	c := lookupCommand(path)
+	if c == nil {
+		return CommandInfo{}, false
+	}
+	return *c, true
+}
+
+// CommandByName returns the command with the generated identifier name,
+// e.g. "DockerContainerLs".
+func CommandByName(name string) (CommandInfo, bool) {
+	indexCommands()
+	c, ok := commandsByName[name]
+	if !ok {
+		return CommandInfo{}, false
+	}
+	return *c, true
+}
+
+func lookupCommand(path []string) *CommandInfo {
+	indexCommands()
+	if len(path) == 0 {
+		return nil
+	}
+
+	c := commandsByPath[path[0]]
+	for _, name := range path[1:] {
+		if c == nil {
+			return nil
+		}
+		c = lookupSubcommand(c, name)
+	}
+	return c
+}
+
+func lookupSubcommand(c *CommandInfo, name string) *CommandInfo {
+	indexCommands()
+	return commandsByParent[strings.Join(c.Path, " ")][name]
+}
+
+// Flag returns the flag with the long name name.
+func (c *CommandInfo) Flag(name string) (FlagInfo, bool) {
+	return c.findFlag(func(f *FlagInfo) bool { return f.Name == name })
+}
+
+// ShorthandFlag returns the flag with the one-letter name shorthand.
+func (c *CommandInfo) ShorthandFlag(shorthand string) (FlagInfo, bool) {
+	return c.findFlag(func(f *FlagInfo) bool { return f.Shorthand == shorthand })
+}
+
+// FieldFlag returns the flag set by the option struct field field.
+func (c *CommandInfo) FieldFlag(field string) (FlagInfo, bool) {
+	return c.findFlag(func(f *FlagInfo) bool { return f.Field == field })
+}
+
+func (c *CommandInfo) findFlag(match func(*FlagInfo) bool) (FlagInfo, bool) {
+	for i := range c.Flags {
+		if match(&c.Flags[i]) {
+			return c.Flags[i], true
+		}
+	}
+	return FlagInfo{}, false
+}
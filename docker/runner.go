@@ -0,0 +1,156 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+)
+
+/*
+Runner runs docker commands in the foreground with the given streams.
+
+While a command runs, the signals in Signals are relayed to the docker
+process. A second relayed signal escalates: containers started with
+RunContainer are killed with 'docker kill' using the Kill option, other
+commands are killed directly.
+*/
+type Runner struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	Signals []os.Signal
+	Kill    DockerKillOption
+
+	// Recorder, if set, records every command run.
+	Recorder *Recorder
+
+	// Policy, if set, checks every command for deprecated flags and flags
+	// the daemon does not support before it is run.
+	Policy *UsagePolicy
+}
+
+// Run runs cmd and returns a *CmdError if it fails.
+func (r *Runner) Run(ctx context.Context, cmd *exec.Cmd) error {
+	return r.run(ctx, cmd, nil, true)
+}
+
+/*
+RunContainer runs a container in the foreground with 'docker run'.
+
+When SigProxy of opt is false docker does not pass signals on to the
+container, so the first signal already kills the container.
+*/
+func (r *Runner) RunContainer(ctx context.Context, opt DockerRunOption, args []string) error {
+	relay := opt.SigProxy == nil || *opt.SigProxy
+
+	var container func() string
+	switch {
+	case opt.Name != nil:
+		name := *opt.Name
+		container = func() string { return name }
+	case opt.Cidfile != nil:
+		path := *opt.Cidfile
+		container = func() string { return readCidfile(path) }
+	default:
+		dir, err := os.MkdirTemp("", "docker-wrapper-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "cid")
+		opt.Cidfile = &path
+		container = func() string { return readCidfile(path) }
+	}
+
+	return r.run(ctx, DockerRunCmd(opt, args), container, relay)
+}
+
+func (r *Runner) run(ctx context.Context, cmd *exec.Cmd, container func() string, relay bool) error {
+	if r.Policy != nil {
+		if err := r.Policy.Check(cmd); err != nil {
+			return err
+		}
+	}
+	if r.Recorder != nil {
+		ctx = WithRecorder(ctx, r.Recorder)
+	}
+
+	c := withContext(ctx, cmd)
+	c.Stdin = r.Stdin
+	c.Stdout = r.Stdout
+
+	tail := &tailBuffer{max: 4096}
+	if r.Stderr != nil {
+		c.Stderr = io.MultiWriter(r.Stderr, tail)
+	} else {
+		c.Stderr = tail
+	}
+
+	if err := c.Start(); err != nil {
+		return cmdError(c, err, "")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	if len(r.Signals) > 0 {
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, r.Signals...)
+		defer signal.Stop(sigs)
+
+		go func() {
+			received := 0
+			if !relay {
+				received++
+			}
+
+			for {
+				select {
+				case sig := <-sigs:
+					received++
+					switch received {
+					case 1:
+						c.Process.Signal(sig)
+					case 2:
+						r.escalate(c, container)
+					}
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	if err := c.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return cmdError(c, err, tail.String())
+	}
+	return nil
+}
+
+func (r *Runner) escalate(cmd *exec.Cmd, container func() string) {
+	if container != nil {
+		if id := container(); id != "" {
+			if _, err := output(context.Background(), DockerKillCmd(r.Kill, []string{id})); err == nil {
+				return
+			}
+		}
+	}
+	cmd.Process.Kill()
+}
+
+func readCidfile(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
@@ -0,0 +1,331 @@
+package docker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Labels stamped on every resource created through a Session.
+const (
+	SessionLabel     = "docker-wrapper.session"
+	SessionPIDLabel  = "docker-wrapper.session.pid"
+	SessionHostLabel = "docker-wrapper.session.host"
+)
+
+/*
+Session labels the containers, networks and volumes created through it and
+removes them on Close. Resources are found both by the IDs the session
+recorded and by the session label, so that containers started from a
+command returned by RunCmd or CreateCmd are cleaned up as well.
+
+Sessions are created with NewSession. The zero value has an empty ID and
+only owns the resources created through it with that empty label.
+*/
+type Session struct {
+	ID string
+
+	mu         sync.Mutex
+	containers []string
+	networks   []string
+	volumes    []string
+	closed     bool
+	stop       chan struct{}
+}
+
+// NewSession returns a session with a random ID.
+func NewSession() (*Session, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		ID:   hex.EncodeToString(b),
+		stop: make(chan struct{}),
+	}, nil
+}
+
+func (s *Session) labels() []string {
+	host, _ := os.Hostname()
+	return []string{
+		SessionLabel + "=" + s.ID,
+		SessionPIDLabel + "=" + strconv.Itoa(os.Getpid()),
+		SessionHostLabel + "=" + host,
+	}
+}
+
+// filter returns the --filter flag selecting the session's resources. It is
+// passed as an argument, which the list commands parse as a flag since they
+// take no arguments.
+func (s *Session) filter() []string {
+	return []string{"--filter=label=" + SessionLabel + "=" + s.ID}
+}
+
+// RunCmd is DockerRunCmd with the session labels added.
+func (s *Session) RunCmd(opt DockerRunOption, args []string) *exec.Cmd {
+	opt.Label = append(append([]string{}, opt.Label...), s.labels()...)
+	return DockerRunCmd(opt, args)
+}
+
+// CreateCmd is DockerCreateCmd with the session labels added.
+func (s *Session) CreateCmd(opt DockerCreateOption, args []string) *exec.Cmd {
+	opt.Label = append(append([]string{}, opt.Label...), s.labels()...)
+	return DockerCreateCmd(opt, args)
+}
+
+// StartContainer is StartContainer with the session labels added. The
+// container is tracked even when a wait strategy fails.
+func (s *Session) StartContainer(ctx context.Context, opt DockerRunOption, args []string, strategies ...WaitStrategy) (*Container, error) {
+	opt.Label = append(append([]string{}, opt.Label...), s.labels()...)
+	c, err := StartContainer(ctx, opt, args, strategies...)
+	if c != nil {
+		s.track(&s.containers, c.ID)
+	}
+	return c, err
+}
+
+// CreateContainer runs 'docker create' and returns the container ID.
+func (s *Session) CreateContainer(ctx context.Context, opt DockerCreateOption, args []string) (string, error) {
+	out, err := output(ctx, s.CreateCmd(opt, args))
+	if err != nil {
+		return "", err
+	}
+
+	id := lastLine(out)
+	s.track(&s.containers, id)
+	return id, nil
+}
+
+// CreateNetwork runs 'docker network create' and returns the network ID.
+func (s *Session) CreateNetwork(ctx context.Context, opt DockerNetworkCreateOption, args []string) (string, error) {
+	opt.Label = append(append([]string{}, opt.Label...), s.labels()...)
+	out, err := output(ctx, DockerNetworkCreateCmd(opt, args))
+	if err != nil {
+		return "", err
+	}
+
+	id := lastLine(out)
+	s.track(&s.networks, id)
+	return id, nil
+}
+
+// CreateVolume runs 'docker volume create' and returns the volume name.
+func (s *Session) CreateVolume(ctx context.Context, opt DockerVolumeCreateOption, args []string) (string, error) {
+	opt.Label = append(append([]string{}, opt.Label...), s.labels()...)
+	out, err := output(ctx, DockerVolumeCreateCmd(opt, args))
+	if err != nil {
+		return "", err
+	}
+
+	name := lastLine(out)
+	s.track(&s.volumes, name)
+	return name, nil
+}
+
+func (s *Session) track(list *[]string, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*list = append(*list, id)
+}
+
+// stopped returns the channel closed by Close, creating it for sessions
+// not made by NewSession. s.mu must be held.
+func (s *Session) stopped() chan struct{} {
+	if s.stop == nil {
+		s.stop = make(chan struct{})
+	}
+	return s.stop
+}
+
+/*
+Close removes every container, network and volume of the session.
+Containers are removed first so that their networks and volumes are no
+longer in use. Close is safe to call more than once.
+*/
+func (s *Session) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.stopped())
+	}
+	containers := append([]string{}, s.containers...)
+	networks := append([]string{}, s.networks...)
+	volumes := append([]string{}, s.volumes...)
+	s.mu.Unlock()
+
+	var errs []error
+
+	ids, err := listIDs(ctx, DockerPsCmd(DockerPsOption{All: ptr(true), Quiet: ptr(true), NoTrunc: ptr(true)}, s.filter()))
+	errs = append(errs, err)
+	errs = append(errs, removeContainers(ctx, union(containers, ids)))
+
+	ids, err = listIDs(ctx, DockerNetworkLsCmd(DockerNetworkLsOption{Quiet: ptr(true), NoTrunc: ptr(true)}, s.filter()))
+	errs = append(errs, err)
+	errs = append(errs, removeNetworks(ctx, union(networks, ids)))
+
+	ids, err = listIDs(ctx, DockerVolumeLsCmd(DockerVolumeLsOption{Quiet: ptr(true)}, s.filter()))
+	errs = append(errs, err)
+	errs = append(errs, removeVolumes(ctx, union(volumes, ids)))
+
+	return joinErrors(errs)
+}
+
+/*
+CloseOnSignal closes the session when one of sigs is received (os.Interrupt
+when none are given), then re-raises the signal with its default handling.
+It stops listening once the session is closed.
+*/
+func (s *Session) CloseOnSignal(sigs ...os.Signal) {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{os.Interrupt}
+	}
+
+	s.mu.Lock()
+	stop := s.stopped()
+	s.mu.Unlock()
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sigs...)
+
+	go func() {
+		defer signal.Stop(ch)
+		select {
+		case sig := <-ch:
+			s.Close(context.Background())
+			signal.Reset(sig)
+			if p, err := os.FindProcess(os.Getpid()); err == nil && p.Signal(sig) == nil {
+				return
+			}
+			os.Exit(1)
+		case <-stop:
+		}
+	}()
+}
+
+/*
+Sweep removes the resources of dead sessions. A session is dead when it was
+created on this host by a process that no longer exists; sessions from other
+hosts are left alone.
+*/
+func Sweep(ctx context.Context) error {
+	host, _ := os.Hostname()
+	format := ptr(`{{.ID}}	{{.Label "` + SessionPIDLabel + `"}}	{{.Label "` + SessionHostLabel + `"}}`)
+	filter := []string{"--filter=label=" + SessionLabel}
+
+	dead := func(cmd *exec.Cmd) ([]string, error) {
+		out, err := output(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			fields := strings.Split(line, "\t")
+			if len(fields) != 3 || fields[2] != host {
+				continue
+			}
+			pid, err := strconv.Atoi(fields[1])
+			if err != nil || processAlive(pid) {
+				continue
+			}
+			ids = append(ids, fields[0])
+		}
+		return ids, nil
+	}
+
+	var errs []error
+
+	ids, err := dead(DockerPsCmd(DockerPsOption{All: ptr(true), Format: format}, filter))
+	errs = append(errs, err, removeContainers(ctx, ids))
+
+	ids, err = dead(DockerNetworkLsCmd(DockerNetworkLsOption{Format: format}, filter))
+	errs = append(errs, err, removeNetworks(ctx, ids))
+
+	volumeFormat := ptr(strings.Replace(*format, ".ID", ".Name", 1))
+	ids, err = dead(DockerVolumeLsCmd(DockerVolumeLsOption{Format: volumeFormat}, filter))
+	errs = append(errs, err, removeVolumes(ctx, ids))
+
+	return joinErrors(errs)
+}
+
+func removeContainers(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := output(ctx, DockerRmCmd(DockerRmOption{Force: ptr(true), Volumes: ptr(true)}, ids))
+	return ignoreNotFound(err)
+}
+
+func removeNetworks(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := output(ctx, DockerNetworkRmCmd(ids))
+	return ignoreNotFound(err)
+}
+
+func removeVolumes(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := output(ctx, DockerVolumeRmCmd(DockerVolumeRmOption{Force: ptr(true)}, names))
+	return ignoreNotFound(err)
+}
+
+// ignoreNotFound drops the errors about resources that no longer exist
+// from the error of a command removing several, keeping the others.
+func ignoreNotFound(err error) error {
+	var cmdErr *CmdError
+	if !errors.As(err, &cmdErr) || strings.TrimSpace(cmdErr.Stderr) == "" {
+		return err
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(cmdErr.Stderr), "\n") {
+		lower := strings.ToLower(line)
+		if strings.Contains(lower, "no such") || strings.Contains(lower, "not found") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	e := *cmdErr
+	e.Stderr = strings.Join(lines, "\n")
+	return &e
+}
+
+func listIDs(ctx context.Context, cmd *exec.Cmd) ([]string, error) {
+	out, err := output(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+func union(a, b []string) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, v := range append(a, b...) {
+		if !seen[v] {
+			seen[v] = true
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func lastLine(out []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,28 @@
+package docker
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIgnoreNotFound(t *testing.T) {
+	tests := []struct {
+		stderr string
+		want   string
+	}{
+		{"Error response from daemon: No such container: a\n", ""},
+		{"Error response from daemon: network b not found\nError response from daemon: get c: no such volume", ""},
+		{"Error response from daemon: No such container: a\nError response from daemon: container d is in use", "Error response from daemon: container d is in use"},
+		{"", "exit status 1"},
+	}
+
+	for _, tt := range tests {
+		err := ignoreNotFound(&CmdError{Args: []string{"docker", "rm"}, Stderr: tt.stderr, Err: errors.New("exit status 1")})
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("ignoreNotFound(%q) = %v, want nil", tt.stderr, err)
+		case tt.want != "" && (err == nil || err.Error() != "docker rm: "+tt.want):
+			t.Errorf("ignoreNotFound(%q) = %v, want %q", tt.stderr, err, tt.want)
+		}
+	}
+}
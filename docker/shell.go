@@ -0,0 +1,217 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ShellQuote quotes s for POSIX shells. Strings made only of safe
+// characters are returned unchanged.
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	safe := true
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("@%+=:,./_-", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ShellJoin quotes and joins args into a shell command line.
+func ShellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, a := range args {
+		quoted[i] = ShellQuote(a)
+	}
+	return strings.Join(quoted, " ")
+}
+
+/*
+ShellString renders cmd as a copy-pasteable POSIX shell line, including the
+environment variables set on cmd that differ from the current environment
+and, if set, the working directory:
+
+	(cd /src && DOCKER_BUILDKIT=1 docker build --tag=app .)
+*/
+func ShellString(cmd *exec.Cmd) string {
+	return shellLine(cmd, cmd.Args, true)
+}
+
+// RedactedShellString is ShellString with secret values redacted, as
+// done by RedactArgs.
+func RedactedShellString(cmd *exec.Cmd) string {
+	return shellLine(cmd, RedactArgs(cmd.Args), true)
+}
+
+func shellLine(cmd *exec.Cmd, args []string, withDir bool) string {
+	line := ShellJoin(args)
+	if env := extraEnv(cmd); len(env) > 0 {
+		line = ShellJoin(env) + " " + line
+	}
+	if withDir && cmd.Dir != "" {
+		line = "(cd " + ShellQuote(cmd.Dir) + " && " + line + ")"
+	}
+	return line
+}
+
+// extraEnv returns the entries of cmd.Env not inherited unchanged from the
+// current environment.
+func extraEnv(cmd *exec.Cmd) []string {
+	if cmd.Env == nil {
+		return nil
+	}
+
+	current := map[string]bool{}
+	for _, e := range os.Environ() {
+		current[e] = true
+	}
+
+	var env []string
+	for _, e := range cmd.Env {
+		if !current[e] {
+			env = append(env, e)
+		}
+	}
+	return env
+}
+
+// workDir returns the directory cmd runs in if started now.
+func workDir(cmd *exec.Cmd) string {
+	if filepath.IsAbs(cmd.Dir) {
+		return cmd.Dir
+	}
+	dir, _ := os.Getwd()
+	return filepath.Join(dir, cmd.Dir)
+}
+
+/*
+Recorder keeps the sequence of commands passed to it so that it can be
+exported as a shell script or Makefile target. Set it on a Runner, on a
+context with WithRecorder, or wrap commands with Cmd. A Recorder is safe for
+concurrent use.
+*/
+type Recorder struct {
+	// Redact replaces secret values in the exported commands.
+	Redact bool
+
+	mu   sync.Mutex
+	cmds []recordedCmd
+}
+
+type recordedCmd struct {
+	cmd *exec.Cmd
+	dir string
+
+	// stdin is set for commands fed through standard input, such as
+	// `docker login --password-stdin` or `docker load`.
+	stdin bool
+}
+
+type recorderKey struct{}
+
+// WithRecorder returns a copy of ctx with which every command run by this
+// package is recorded by r: those of Runner and also those the helpers such
+// as StartContainer, PullImage or Session.Close run.
+func WithRecorder(ctx context.Context, r *Recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, r)
+}
+
+func recorderFrom(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(recorderKey{}).(*Recorder)
+	return r
+}
+
+// Record appends cmd to the recorded sequence, along with the directory it
+// runs in, which is the current one unless cmd.Dir is set. Commands with
+// Stdin set are marked in the exports, as what they read is not recorded.
+func (r *Recorder) Record(cmd *exec.Cmd) {
+	rec := recordedCmd{cmd: cmd, dir: workDir(cmd), stdin: cmd.Stdin != nil}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cmds = append(r.cmds, rec)
+}
+
+// Cmd records cmd and returns it.
+func (r *Recorder) Cmd(cmd *exec.Cmd) *exec.Cmd {
+	r.Record(cmd)
+	return cmd
+}
+
+// Commands returns the recorded commands.
+func (r *Recorder) Commands() []*exec.Cmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cmds := make([]*exec.Cmd, len(r.cmds))
+	for i, rec := range r.cmds {
+		cmds[i] = rec.cmd
+	}
+	return cmds
+}
+
+func (r *Recorder) recorded() []recordedCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]recordedCmd{}, r.cmds...)
+}
+
+// line renders a recorded command without its working directory.
+func (r *Recorder) line(rec recordedCmd) string {
+	args := rec.cmd.Args
+	if r.Redact {
+		args = RedactArgs(args)
+	}
+	line := shellLine(rec.cmd, args, false)
+	if rec.stdin {
+		line += " # reads standard input, which was not recorded"
+	}
+	return line
+}
+
+// WriteScript writes the recorded commands as a standalone bash script,
+// changing to the working directory of each command.
+func (r *Recorder) WriteScript(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("#!/usr/bin/env bash\nset -euo pipefail\n\n")
+
+	dir := ""
+	for _, rec := range r.recorded() {
+		if rec.dir != dir {
+			dir = rec.dir
+			b.WriteString("cd " + ShellQuote(dir) + "\n")
+		}
+		b.WriteString(r.line(rec) + "\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMakefile writes the recorded commands as the recipe of a phony
+// Makefile target. Each recipe line changes to the working directory of
+// its command.
+func (r *Recorder) WriteMakefile(w io.Writer, target string) error {
+	var b strings.Builder
+	b.WriteString(".PHONY: " + target + "\n" + target + ":\n")
+
+	for _, rec := range r.recorded() {
+		line := "cd " + ShellQuote(rec.dir) + " && " + r.line(rec)
+		b.WriteString("\t" + strings.ReplaceAll(line, "$", "$$") + "\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,61 @@
+package docker
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "''"},
+		{"alpine:3.18", "alpine:3.18"},
+		{"--filter=label=a,b", "--filter=label=a,b"},
+		{"/usr/local/bin", "/usr/local/bin"},
+		{"user@example.com", "user@example.com"},
+		{"a b", "'a b'"},
+		{"$HOME", "'$HOME'"},
+		{"it's", `'it'\''s'`},
+		{"a\nb", "'a\nb'"},
+		{"*", "'*'"},
+		{"ünïcode", "'ünïcode'"},
+	}
+
+	for _, tt := range tests {
+		if got := ShellQuote(tt.in); got != tt.want {
+			t.Errorf("ShellQuote(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+		if words, err := SplitShellWords(ShellQuote(tt.in)); err != nil || len(words) != 1 || words[0] != tt.in {
+			t.Errorf("SplitShellWords(ShellQuote(%q)) = %q, %v", tt.in, words, err)
+		}
+	}
+}
+
+func TestWithRecorder(t *testing.T) {
+	fakeDocker(t, "cat >/dev/null\n")
+
+	r := &Recorder{Redact: true}
+	ctx := WithRecorder(context.Background(), r)
+	if err := Login(ctx, DockerLoginOption{Username: ptr("me")}, "registry.example.com", strings.NewReader("s3cret")); err != nil {
+		t.Fatal(err)
+	}
+	if err := PullImage(ctx, DockerPullOption{}, MustParseReference("alpine")); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := r.WriteScript(&b); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"docker login --password-stdin=true --username=me registry.example.com # reads standard input, which was not recorded\n",
+		"docker pull docker.io/library/alpine:latest\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("script = %q, want it to contain %q", b.String(), want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+)
+
+// ErrTerminalUnsupported is returned by the terminal helpers on platforms
+// without pseudo-terminal support.
+var ErrTerminalUnsupported = errors.New("terminal sessions are not supported on this platform")
+
+/*
+AttachTerminal attaches the local terminal to a running container with
+'docker attach'. The local terminal is put into raw mode for the duration
+of the session, window size changes are forwarded, and the DetachKeys of
+opt detach from the container without stopping it.
+*/
+func AttachTerminal(ctx context.Context, opt DockerAttachOption, container string) error {
+	return runTerminal(ctx, DockerAttachCmd(opt, []string{container}))
+}
+
+// ExecTerminal runs cmd inside container in an interactive terminal
+// session. Interactive and Tty of opt are always set and Detach is ignored.
+func ExecTerminal(ctx context.Context, container string, opt DockerExecOption, cmd []string) error {
+	opt.Interactive = ptr(true)
+	opt.Tty = ptr(true)
+	opt.Detach = nil
+	return runTerminal(ctx, DockerExecCmd(opt, append([]string{container}, cmd...)))
+}
+
+// RunTerminal runs a container in an interactive terminal session.
+// Interactive and Tty of opt are always set and Detach is ignored.
+func RunTerminal(ctx context.Context, opt DockerRunOption, args []string) error {
+	opt.Interactive = ptr(true)
+	opt.Tty = ptr(true)
+	opt.Detach = nil
+	return runTerminal(ctx, DockerRunCmd(opt, args))
+}
+
+// RunInTerminal runs an arbitrary docker command in a terminal session.
+func RunInTerminal(ctx context.Context, cmd *exec.Cmd) error {
+	return runTerminal(ctx, cmd)
+}
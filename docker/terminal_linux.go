@@ -0,0 +1,265 @@
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct {
+	Row, Col, X, Y uint16
+}
+
+func runTerminal(ctx context.Context, cmd *exec.Cmd) error {
+	master, slave, err := openPTY()
+	if err != nil {
+		return err
+	}
+	defer master.Close()
+
+	in, out := os.Stdin, os.Stdout
+	if isTerminal(in.Fd()) {
+		restore, err := makeRaw(in.Fd())
+		if err != nil {
+			slave.Close()
+			return err
+		}
+		defer restore()
+	}
+
+	resize := func() {
+		if ws, err := getWinsize(out.Fd()); err == nil {
+			setWinsize(master.Fd(), ws)
+		}
+	}
+	resize()
+
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-winch:
+				resize()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	c := withContext(ctx, cmd)
+	c.Stdin = slave
+	c.Stdout = slave
+	c.Stderr = slave
+	c.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+
+	if err := c.Start(); err != nil {
+		slave.Close()
+		return err
+	}
+	slave.Close()
+
+	stopInput, err := copyInput(master, in)
+	if err != nil {
+		c.Process.Kill()
+		c.Wait()
+		return err
+	}
+	copied := make(chan struct{})
+	go func() {
+		// Reading from the master fails with EIO once the child exits.
+		io.Copy(out, master)
+		close(copied)
+	}()
+
+	err = c.Wait()
+	<-copied
+	stopInput()
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+/*
+startTerminalOutput starts c with its standard output on a pty, so that
+docker prints progress bars as it does in a terminal, and returns the
+output. The pty is wide enough for docker not to cut the lines short. If no
+pty can be opened, the output is read from a pipe and docker prints state
+changes only.
+*/
+func startTerminalOutput(c *exec.Cmd) (io.ReadCloser, error) {
+	master, slave, err := openPTY()
+	if err != nil {
+		return startPipeOutput(c)
+	}
+	setWinsize(master.Fd(), winsize{Row: 24, Col: 512})
+
+	c.Stdout = slave
+	err = c.Start()
+	slave.Close()
+	if err != nil {
+		master.Close()
+		return nil, err
+	}
+	return ptyReader{master}, nil
+}
+
+// ptyReader reads from a pty master, which fails with EIO instead of
+// returning EOF once the other side is closed.
+type ptyReader struct {
+	*os.File
+}
+
+func (r ptyReader) Read(p []byte) (int, error) {
+	n, err := r.File.Read(p)
+	if errors.Is(err, syscall.EIO) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+type pollFd struct {
+	fd      int32
+	events  int16
+	revents int16
+}
+
+const pollIn = 0x1
+
+/*
+copyInput copies in to w until in reaches EOF or the returned function is
+called. Reads from a terminal cannot be interrupted, so in is polled
+together with a pipe that stop closes; once stop returns, in is no longer
+read and what the user types next goes to the program again.
+*/
+func copyInput(w io.Writer, in *os.File) (stop func(), err error) {
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer pr.Close()
+
+		buf := make([]byte, 32*1024)
+		for {
+			fds := []pollFd{
+				{fd: int32(in.Fd()), events: pollIn},
+				{fd: int32(pr.Fd()), events: pollIn},
+			}
+			_, _, errno := syscall.Syscall6(syscall.SYS_PPOLL, uintptr(unsafe.Pointer(&fds[0])), uintptr(len(fds)), 0, 0, 0, 0)
+			if errno == syscall.EINTR {
+				continue
+			}
+			if errno != 0 || fds[1].revents != 0 {
+				return
+			}
+
+			n, err := in.Read(buf)
+			if n > 0 {
+				if _, err := w.Write(buf[:n]); err != nil {
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return func() {
+		pw.Close()
+		<-done
+	}, nil
+}
+
+func openPTY() (*os.File, *os.File, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open pty: %w", err)
+	}
+
+	var unlock int32
+	if err := ioctl(master.Fd(), syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("unlock pty: %w", err)
+	}
+
+	var n uint32
+	if err := ioctl(master.Fd(), syscall.TIOCGPTN, uintptr(unsafe.Pointer(&n))); err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("get pty number: %w", err)
+	}
+
+	slave, err := os.OpenFile("/dev/pts/"+strconv.Itoa(int(n)), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("open pty slave: %w", err)
+	}
+
+	return master, slave, nil
+}
+
+func isTerminal(fd uintptr) bool {
+	var t syscall.Termios
+	return ioctl(fd, syscall.TCGETS, uintptr(unsafe.Pointer(&t))) == nil
+}
+
+// makeRaw puts the terminal into raw mode like cfmakeraw(3) and returns a
+// function restoring the previous state.
+func makeRaw(fd uintptr) (func(), error) {
+	var old syscall.Termios
+	if err := ioctl(fd, syscall.TCGETS, uintptr(unsafe.Pointer(&old))); err != nil {
+		return nil, fmt.Errorf("get terminal state: %w", err)
+	}
+
+	raw := old
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Oflag &^= syscall.OPOST
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+
+	if err := ioctl(fd, syscall.TCSETS, uintptr(unsafe.Pointer(&raw))); err != nil {
+		return nil, fmt.Errorf("set raw mode: %w", err)
+	}
+
+	return func() {
+		ioctl(fd, syscall.TCSETS, uintptr(unsafe.Pointer(&old)))
+	}, nil
+}
+
+func getWinsize(fd uintptr) (winsize, error) {
+	var ws winsize
+	err := ioctl(fd, syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&ws)))
+	return ws, err
+}
+
+func setWinsize(fd uintptr, ws winsize) error {
+	return ioctl(fd, syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&ws)))
+}
+
+func ioctl(fd, req, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, arg)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+
+package docker
+
+import (
+	"context"
+	"io"
+	"os/exec"
+)
+
+func runTerminal(ctx context.Context, cmd *exec.Cmd) error {
+	return ErrTerminalUnsupported
+}
+
+// startTerminalOutput starts c and returns its output. Without a pty docker
+// prints state changes only, not progress bars.
+func startTerminalOutput(c *exec.Cmd) (io.ReadCloser, error) {
+	return startPipeOutput(c)
+}
@@ -0,0 +1,50 @@
+package docker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var sizeUnits = map[string]float64{
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	"pib": 1 << 50,
+}
+
+// parseSize parses the human readable sizes docker prints, e.g. "1.2GB",
+// "3.4kB" or "512B". Decimal units are powers of 1000 as in docker's
+// output; binary units such as "MiB" are accepted too.
+func parseSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(s)
+	}
+
+	n, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	unit := strings.ToLower(strings.TrimSpace(s[i:]))
+	if unit == "" {
+		unit = "b"
+	}
+	mult, ok := sizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size unit in %q", s)
+	}
+
+	return int64(n * mult), nil
+}
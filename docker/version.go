@@ -0,0 +1,121 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// Version is the output of `docker version --format '{{json .}}'`.
+type Version struct {
+	Client ClientVersion
+
+	// Server is nil if the output has no server section.
+	Server *ServerVersion
+}
+
+// ClientVersion describes the docker CLI.
+type ClientVersion struct {
+	Platform          Platform
+	Version           string
+	APIVersion        string `json:"ApiVersion"`
+	DefaultAPIVersion string
+	GitCommit         string
+	GoVersion         string
+	Os                string
+	Arch              string
+	BuildTime         string
+	Context           string
+	Experimental      bool
+}
+
+// ServerVersion describes the daemon.
+type ServerVersion struct {
+	Platform      Platform
+	Components    []ComponentVersion
+	Version       string
+	APIVersion    string `json:"ApiVersion"`
+	MinAPIVersion string
+	GitCommit     string
+	GoVersion     string
+	Os            string
+	Arch          string
+	KernelVersion string
+	Experimental  bool
+	BuildTime     string
+}
+
+// Platform names the product, e.g. "Docker Engine - Community".
+type Platform struct {
+	Name string
+}
+
+// ComponentVersion is a component of the engine, such as "Engine",
+// "containerd" or "runc".
+type ComponentVersion struct {
+	Name    string
+	Version string
+	Details map[string]string
+}
+
+// ParseVersion decodes the output of `docker version --format '{{json .}}'`.
+func ParseVersion(data []byte) (*Version, error) {
+	var v Version
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetVersion runs `docker version` with the global options opt. docker
+// fails when it cannot reach the daemon, but still prints the client
+// section; the version is then returned with a nil Server and no error.
+func GetVersion(ctx context.Context, opt DockerOption) (*Version, error) {
+	cmd := withGlobal(DockerVersionCmd(DockerVersionOption{Format: ptr("{{json .}}")}, nil), opt)
+	out, err := partialOutput(ctx, cmd)
+	if err != nil && (ctx.Err() != nil || len(bytes.TrimSpace(out)) == 0) {
+		return nil, err
+	}
+	v, perr := ParseVersion(out)
+	if perr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, perr
+	}
+	if err != nil && v.Server != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Component returns the server component called name, e.g. "containerd".
+func (v *Version) Component(name string) (ComponentVersion, bool) {
+	if v.Server == nil {
+		return ComponentVersion{}, false
+	}
+	for _, c := range v.Server.Components {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return ComponentVersion{}, false
+}
+
+// BuildKitAvailable reports whether the daemon can run BuildKit builds,
+// which needs a Linux daemon with API version 1.39 or later.
+func (v *Version) BuildKitAvailable() bool {
+	return v.Server != nil && v.Server.Os == "linux" && !versionLess(v.Server.APIVersion, "1.39")
+}
+
+// Features returns the daemon features known from the version.
+func (v *Version) Features() *DaemonFeatures {
+	if v.Server == nil {
+		return nil
+	}
+	return &DaemonFeatures{
+		APIVersion:   v.Server.APIVersion,
+		OSType:       v.Server.Os,
+		Experimental: ptr(v.Server.Experimental),
+	}
+}
@@ -0,0 +1,202 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const (
+	defaultWaitTimeout  = time.Minute
+	defaultPollInterval = 200 * time.Millisecond
+)
+
+// WaitStrategy decides when a started container is ready for use.
+type WaitStrategy interface {
+	WaitUntilReady(ctx context.Context, c *Container) error
+}
+
+// WaitForLog waits until Pattern matches the container logs at least
+// Occurrences times (once by default).
+type WaitForLog struct {
+	Pattern      *regexp.Regexp
+	Occurrences  int
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+func (w WaitForLog) WaitUntilReady(ctx context.Context, c *Container) error {
+	want := w.Occurrences
+	if want <= 0 {
+		want = 1
+	}
+
+	return poll(ctx, w.Timeout, w.PollInterval, func(ctx context.Context) (bool, error) {
+		logs, err := c.Logs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(w.Pattern.FindAllStringIndex(logs, want)) >= want, nil
+	}, "log pattern %q", w.Pattern)
+}
+
+// WaitForPort waits until a TCP connection to the host binding of Port,
+// e.g. "5432/tcp", succeeds.
+type WaitForPort struct {
+	Port         string
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+func (w WaitForPort) WaitUntilReady(ctx context.Context, c *Container) error {
+	addr, err := c.Address(w.Port)
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	return poll(ctx, w.Timeout, w.PollInterval, func(ctx context.Context) (bool, error) {
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return false, nil
+		}
+		conn.Close()
+		return true, nil
+	}, "port %s (%s)", w.Port, addr)
+}
+
+/*
+WaitForHTTP waits until a GET request to Path on the host binding of Port
+answers with StatusCode (200 by default). Scheme is "http" by default; for
+"https" services with self-signed certificates, give a Client that trusts
+them. Requests are made with Client, or http.DefaultClient if nil, and each
+is bounded by the time remaining until Timeout.
+*/
+type WaitForHTTP struct {
+	Port         string
+	Path         string
+	Scheme       string
+	Client       *http.Client
+	StatusCode   int
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+func (w WaitForHTTP) WaitUntilReady(ctx context.Context, c *Container) error {
+	addr, err := c.Address(w.Port)
+	if err != nil {
+		return err
+	}
+
+	scheme := w.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	url := scheme + "://" + addr + w.Path
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	want := w.StatusCode
+	if want == 0 {
+		want = http.StatusOK
+	}
+
+	return poll(ctx, w.Timeout, w.PollInterval, func(ctx context.Context) (bool, error) {
+		// ctx carries the deadline of the wait, so a request that hangs
+		// is given up when the wait times out.
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return false, nil
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+		return resp.StatusCode == want, nil
+	}, "HTTP %d from %s", want, url)
+}
+
+// WaitForHealthy waits until the docker healthcheck of the container
+// reports "healthy".
+type WaitForHealthy struct {
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+func (w WaitForHealthy) WaitUntilReady(ctx context.Context, c *Container) error {
+	return poll(ctx, w.Timeout, w.PollInterval, func(ctx context.Context) (bool, error) {
+		state, err := c.State(ctx)
+		if err != nil {
+			return false, err
+		}
+		if !state.Running {
+			return false, fmt.Errorf("container %s is %s (exit code %d)", c.ID, state.Status, state.ExitCode)
+		}
+		if state.Health == "" {
+			return false, fmt.Errorf("container %s has no healthcheck", c.ID)
+		}
+		return state.Health == "healthy", nil
+	}, "healthy status")
+}
+
+// WaitForExec waits until Cmd executed inside the container exits with 0.
+type WaitForExec struct {
+	Cmd          []string
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+func (w WaitForExec) WaitUntilReady(ctx context.Context, c *Container) error {
+	args := append([]string{c.ID}, w.Cmd...)
+	return poll(ctx, w.Timeout, w.PollInterval, func(ctx context.Context) (bool, error) {
+		_, err := output(ctx, DockerExecCmd(DockerExecOption{}, args))
+		var cmdErr *CmdError
+		if errors.As(err, &cmdErr) {
+			return false, nil
+		}
+		return err == nil, err
+	}, "command %q", w.Cmd)
+}
+
+// poll calls cond every interval until it reports true, returns an error,
+// or timeout elapses.
+func poll(ctx context.Context, timeout, interval time.Duration, cond func(context.Context) (bool, error), format string, a ...interface{}) error {
+	if timeout <= 0 {
+		timeout = defaultWaitTimeout
+	}
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := cond(ctx)
+		if ok {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for %s: %w", fmt.Sprintf(format, a...), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package docker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testContainer(t *testing.T, url string) *Container {
+	t.Helper()
+	host, port, err := net.SplitHostPort(url[len("https://"):])
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, _ := strconv.Atoi(port)
+	return &Container{ID: "web", Ports: map[string][]HostBinding{"443/tcp": {{IP: host, Port: n}}}}
+}
+
+func TestWaitForHTTPS(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 || r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	w := WaitForHTTP{
+		Port:         "443/tcp",
+		Path:         "/health",
+		Scheme:       "https",
+		Client:       srv.Client(),
+		Timeout:      5 * time.Second,
+		PollInterval: time.Millisecond,
+	}
+	if err := w.WaitUntilReady(context.Background(), testContainer(t, srv.URL)); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("requests = %d, want 3", n)
+	}
+}
+
+func TestWaitForHTTPHangingRequest(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	w := WaitForHTTP{Port: "443", Scheme: "https", Client: srv.Client(), Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	if err := w.WaitUntilReady(context.Background(), testContainer(t, srv.URL)); err == nil {
+		t.Fatal("WaitUntilReady succeeded, want a timeout")
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("WaitUntilReady took %v, want it to give up the request at the timeout", d)
+	}
+}